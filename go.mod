@@ -36,6 +36,7 @@ require (
 	github.com/denis-tingaikin/go-header v0.5.0
 	github.com/fatih/color v1.17.0
 	github.com/firefart/nonamedreturns v1.0.5
+	github.com/fsnotify/fsnotify v1.5.4
 	github.com/fzipp/gocyclo v0.6.0
 	github.com/ghostiam/protogetter v0.3.8
 	github.com/go-critic/go-critic v0.11.4
@@ -142,7 +143,6 @@ require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/ettle/strcase v0.2.0 // indirect
 	github.com/fatih/structtag v1.2.0 // indirect
-	github.com/fsnotify/fsnotify v1.5.4 // indirect
 	github.com/go-ole/go-ole v1.2.6 // indirect
 	github.com/go-toolsmith/astcast v1.1.0 // indirect
 	github.com/go-toolsmith/astcopy v1.1.0 // indirect
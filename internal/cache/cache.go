@@ -19,6 +19,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/golangci/golangci-lint/internal/renameio"
@@ -37,6 +38,9 @@ type OutputID [HashSize]byte
 type Cache struct {
 	dir string
 	now func() time.Time
+
+	backend   Backend // optional shared storage.
+	backendMu sync.Mutex
 }
 
 // Open opens and returns the cache in the given directory.
@@ -140,13 +144,27 @@ type Entry struct {
 
 // get is Get but does not respect verify mode, so that Put can use it.
 func (c *Cache) get(id ActionID) (Entry, error) {
+	fileName := c.fileName(id, "a")
+	entry, err := readIndexEntry(fileName, id)
+	if err != nil {
+		return Entry{}, err
+	}
+
+	if err = c.used(fileName); err != nil {
+		return Entry{}, fmt.Errorf("failed to mark %s as used: %w", fileName, err)
+	}
+
+	return entry, nil
+}
+
+// readIndexEntry reads the index entry (xxxx-a file) of the action ID.
+func readIndexEntry(fileName string, id ActionID) (Entry, error) {
 	missing := func() (Entry, error) {
 		return Entry{}, errMissing
 	}
 	failed := func(err error) (Entry, error) {
 		return Entry{}, err
 	}
-	fileName := c.fileName(id, "a")
 	f, err := os.Open(fileName)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -190,10 +208,6 @@ func (c *Cache) get(id ActionID) (Entry, error) {
 		return failed(fmt.Errorf("failed to parse etime int from %s with error %w", fileName, err))
 	}
 
-	if err = c.used(fileName); err != nil {
-		return failed(fmt.Errorf("failed to mark %s as used: %w", fileName, err))
-	}
-
 	return Entry{buf, size, time.Unix(0, tm)}, nil
 }
 
@@ -203,6 +217,9 @@ func (c *Cache) get(id ActionID) (Entry, error) {
 func (c *Cache) GetBytes(id ActionID) ([]byte, Entry, error) {
 	entry, err := c.Get(id)
 	if err != nil {
+		if IsErrMissing(err) && !verify {
+			return c.getRemoteBytes(id)
+		}
 		return nil, entry, err
 	}
 	outputFile, err := c.OutputFile(entry.OutputID)
@@ -326,7 +343,7 @@ func (c *Cache) trimSubdir(subdir string, cutoff time.Time) {
 		entry := filepath.Join(subdir, name)
 		info, err := os.Stat(entry)
 		if err == nil && info.ModTime().Before(cutoff) {
-			os.Remove(entry)
+			c.removeFile(entry)
 		}
 	}
 }
@@ -429,7 +446,10 @@ func (c *Cache) put(id ActionID, file io.ReadSeeker, allowVerify bool) (OutputID
 // PutBytes stores the given bytes in the cache as the output for the action ID.
 func (c *Cache) PutBytes(id ActionID, data []byte) error {
 	_, _, err := c.Put(id, bytes.NewReader(data))
-	return err
+	if err != nil {
+		return err
+	}
+	return c.putRemoteBytes(id, data)
 }
 
 // copyFile copies file into the cache, expecting it to have the given
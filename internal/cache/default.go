@@ -49,6 +49,15 @@ func initDefaultCache() {
 	if err != nil {
 		log.Fatalf("failed to initialize build cache at %s: %s\n", dir, err)
 	}
+
+	if remoteURL := getRemoteURL(); remoteURL != "" {
+		b, backendErr := NewHTTPBackend(remoteURL)
+		if backendErr != nil {
+			log.Fatalf("failed to initialize remote cache: %s\n", backendErr)
+		}
+		c.SetBackend(b)
+	}
+
 	defaultCache = c
 }
 
@@ -184,3 +184,11 @@ func SetFileHash(file string, sum [HashSize]byte) {
 	hashFileCache.m[file] = sum
 	hashFileCache.Unlock()
 }
+
+// ResetFileHashes forgets the hashes of the files computed by FileHash.
+// It must be called when the files are changed inside a long-lived process.
+func ResetFileHashes() {
+	hashFileCache.Lock()
+	hashFileCache.m = nil
+	hashFileCache.Unlock()
+}
@@ -7,6 +7,7 @@ package cache
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -49,3 +50,30 @@ func TestHashFile(t *testing.T) {
 		t.Errorf("hash(hello world) = %v, want %v", sum, want)
 	}
 }
+
+func TestResetFileHashes(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(name, []byte("hello"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	before, err := FileHash(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(name, []byte("hello world"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ResetFileHashes()
+
+	after, err := FileHash(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if before == after {
+		t.Errorf("hash of the changed file = %x, want a different hash", after)
+	}
+}
@@ -0,0 +1,253 @@
+package cache
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golangci/golangci-lint/internal/renameio"
+)
+
+// EntryInfo describes an entry of the cache, for the inspection of the cache.
+type EntryInfo struct {
+	ID ActionID
+	Entry
+
+	// Last use of the entry.
+	Used time.Time
+
+	// Optional metadata (PutMeta).
+	Meta []byte
+}
+
+// PutMeta stores the metadata describing the entry of the action ID.
+// The metadata is not used by the cache itself: it's only read by Entries.
+// The file is replaced atomically: a concurrent reader never reads a partial metadata.
+func (c *Cache) PutMeta(id ActionID, meta []byte) error {
+	return renameio.WriteFile(c.fileName(id, "m"), meta, 0666)
+}
+
+// GetMeta returns the metadata of the entry of the action ID, nil if there is no metadata.
+func (c *Cache) GetMeta(id ActionID) ([]byte, error) {
+	meta, err := renameio.ReadFile(c.fileName(id, "m"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	return meta, err
+}
+
+// Entries returns all the entries of the cache.
+func (c *Cache) Entries() ([]EntryInfo, error) {
+	var entries []EntryInfo
+
+	for i := 0; i < 256; i++ {
+		subdir := filepath.Join(c.dir, fmt.Sprintf("%02x", i))
+
+		names, err := readDirNames(subdir)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, name := range names {
+			hexID, ok := strings.CutSuffix(name, "-a")
+			if !ok {
+				continue
+			}
+
+			var id ActionID
+			if n, err := hex.Decode(id[:], []byte(hexID)); err != nil || n != HashSize {
+				continue
+			}
+
+			fileName := filepath.Join(subdir, name)
+
+			info, err := os.Stat(fileName)
+			if err != nil {
+				continue
+			}
+
+			entry, err := readIndexEntry(fileName, id)
+			if err != nil {
+				continue
+			}
+
+			meta, _ := c.GetMeta(id)
+
+			entries = append(entries, EntryInfo{ID: id, Entry: entry, Used: info.ModTime(), Meta: meta})
+		}
+	}
+
+	return entries, nil
+}
+
+// Remove removes the entry of the action ID.
+// The output is kept: it can be shared with other entries, and it's removed by the trimming.
+func (c *Cache) Remove(id ActionID) error {
+	if err := os.Remove(c.fileName(id, "a")); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	if err := os.Remove(c.fileName(id, "m")); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// TrimResult is the result of TrimTo.
+type TrimResult struct {
+	RemovedFiles int
+	RemovedBytes int64
+}
+
+// TrimTo removes the cache entries unused since the cutoff (if not zero),
+// then the least recently used entries until the size of the cache is less than maxSize (if greater than 0).
+//
+// An entry is removed with its metadata and its output (if the output is not used by another entry):
+// the outputs without entries are removed like entries.
+func (c *Cache) TrimTo(cutoff time.Time, maxSize int64) (TrimResult, error) {
+	type trimEntry struct {
+		action string // The index file (xxxx-a), empty for an output without entry.
+		output string // The output file (xxxx-d).
+		used   time.Time
+	}
+
+	var (
+		entries   []trimEntry
+		files     = map[string]os.FileInfo{} // The files of the cache (xxxx-a and xxxx-d).
+		refs      = map[string]int{}         // The number of entries using each output.
+		totalSize int64
+		res       TrimResult
+	)
+
+	for i := 0; i < 256; i++ {
+		subdir := filepath.Join(c.dir, fmt.Sprintf("%02x", i))
+
+		names, err := readDirNames(subdir)
+		if err != nil {
+			return res, err
+		}
+
+		for _, name := range names {
+			// Only the cache entries (xxxx-a) and the outputs (xxxx-d) are counted: the metadata (xxxx-m) is removed with its entry.
+			if !strings.HasSuffix(name, "-a") && !strings.HasSuffix(name, "-d") {
+				continue
+			}
+
+			path := filepath.Join(subdir, name)
+
+			info, err := os.Stat(path)
+			if err != nil {
+				continue
+			}
+
+			files[path] = info
+			totalSize += info.Size()
+
+			hexID, ok := strings.CutSuffix(name, "-a")
+			if !ok {
+				continue
+			}
+
+			e := trimEntry{action: path, used: info.ModTime()}
+
+			var id ActionID
+			if n, err := hex.Decode(id[:], []byte(hexID)); err == nil && n == HashSize {
+				if entry, err := readIndexEntry(path, id); err == nil {
+					e.output = c.fileName(entry.OutputID, "d")
+					refs[e.output]++
+				}
+			}
+
+			entries = append(entries, e)
+		}
+	}
+
+	for path, info := range files {
+		if strings.HasSuffix(path, "-d") && refs[path] == 0 {
+			entries = append(entries, trimEntry{output: path, used: info.ModTime()})
+		}
+	}
+
+	removeFile := func(path string) {
+		info, ok := files[path]
+		if !ok {
+			return
+		}
+
+		if c.removeFile(path) == nil {
+			delete(files, path)
+
+			res.RemovedFiles++
+			res.RemovedBytes += info.Size()
+			totalSize -= info.Size()
+		}
+	}
+
+	removeEntry := func(e trimEntry) {
+		if e.action != "" {
+			removeFile(e.action)
+		}
+
+		if e.output == "" {
+			return
+		}
+
+		refs[e.output]--
+
+		if refs[e.output] <= 0 {
+			removeFile(e.output)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].used.Before(entries[j].used)
+	})
+
+	for _, e := range entries {
+		if !cutoff.IsZero() && e.used.Before(cutoff) {
+			removeEntry(e)
+			continue
+		}
+
+		if maxSize > 0 && totalSize > maxSize {
+			removeEntry(e)
+		}
+	}
+
+	return res, nil
+}
+
+// removeFile removes a cache file, and the metadata of the entry.
+func (c *Cache) removeFile(path string) error {
+	if err := os.Remove(path); err != nil {
+		return err
+	}
+
+	if hexID, ok := strings.CutSuffix(path, "-a"); ok {
+		_ = os.Remove(hexID + "-m")
+	}
+
+	return nil
+}
+
+func readDirNames(dir string) ([]string, error) {
+	f, err := os.Open(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	// Ignore the error: the entries found before the error are used.
+	names, _ := f.Readdirnames(-1)
+
+	return names, nil
+}
@@ -0,0 +1,175 @@
+package cache
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestEntries(t *testing.T) {
+	t.Parallel()
+
+	c, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	if err = c.PutBytes(dummyID(1), []byte("data1")); err != nil {
+		t.Fatalf("PutBytes: %v", err)
+	}
+	if err = c.PutMeta(dummyID(1), []byte("meta1")); err != nil {
+		t.Fatalf("PutMeta: %v", err)
+	}
+	if err = c.PutBytes(dummyID(2), []byte("data2")); err != nil {
+		t.Fatalf("PutBytes: %v", err)
+	}
+
+	entries, err := c.Entries()
+	if err != nil {
+		t.Fatalf("Entries: %v", err)
+	}
+
+	if len(entries) != 2 {
+		t.Fatalf("Entries: got %d entries, want 2", len(entries))
+	}
+
+	for _, entry := range entries {
+		switch entry.ID {
+		case dummyID(1):
+			if !bytes.Equal(entry.Meta, []byte("meta1")) || entry.Size != 5 {
+				t.Errorf("entry 1: meta %q, size %d", entry.Meta, entry.Size)
+			}
+		case dummyID(2):
+			if entry.Meta != nil {
+				t.Errorf("entry 2: meta %q, want nil", entry.Meta)
+			}
+		default:
+			t.Errorf("unexpected entry %x", entry.ID)
+		}
+	}
+
+	if err = c.Remove(dummyID(1)); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+
+	if _, _, err = c.GetBytes(dummyID(1)); !IsErrMissing(err) {
+		t.Fatalf("GetBytes(1) after Remove: %v, want missing", err)
+	}
+}
+
+func TestGetMeta(t *testing.T) {
+	t.Parallel()
+
+	c, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	meta, err := c.GetMeta(dummyID(1))
+	if err != nil || meta != nil {
+		t.Fatalf("GetMeta without metadata: %q, %v, want nil", meta, err)
+	}
+
+	for _, data := range []string{"meta-long", "meta"} {
+		if err = c.PutMeta(dummyID(1), []byte(data)); err != nil {
+			t.Fatalf("PutMeta: %v", err)
+		}
+
+		meta, err = c.GetMeta(dummyID(1))
+		if err != nil || string(meta) != data {
+			t.Fatalf("GetMeta: %q, %v, want %q", meta, err, data)
+		}
+	}
+}
+
+func TestTrimTo(t *testing.T) {
+	t.Parallel()
+
+	c, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	// Each entry has 2 files: the index (entrySize bytes) and the data (10 bytes).
+	for i := 1; i <= 3; i++ {
+		if err = c.PutBytes(dummyID(i), []byte(fmt.Sprintf("data-%04d", i)+"\n")); err != nil {
+			t.Fatalf("PutBytes: %v", err)
+		}
+	}
+
+	// Nothing is older than one hour.
+	res, err := c.TrimTo(now.Add(-time.Hour), 0)
+	if err != nil || res.RemovedFiles != 0 {
+		t.Fatalf("TrimTo(cutoff) = %+v, %v, want nothing removed", res, err)
+	}
+
+	// The size of 2 entries: the index and the data of an entry are removed together.
+	res, err = c.TrimTo(time.Time{}, 2*(entrySize+10))
+	if err != nil || res.RemovedFiles != 2 {
+		t.Fatalf("TrimTo(maxSize) = %+v, %v, want 2 files removed", res, err)
+	}
+
+	var found int
+	for i := 1; i <= 3; i++ {
+		data, _, err := c.GetBytes(dummyID(i))
+		switch {
+		case err == nil:
+			if string(data) != fmt.Sprintf("data-%04d", i)+"\n" {
+				t.Errorf("GetBytes(%d) = %q", i, data)
+			}
+			found++
+		case !IsErrMissing(err):
+			t.Errorf("GetBytes(%d): %v, want found or missing", i, err)
+		}
+	}
+
+	if found != 2 {
+		t.Fatalf("TrimTo(maxSize): %d complete entries, want 2", found)
+	}
+
+	// Everything is older than the future.
+	res, err = c.TrimTo(now.Add(time.Hour), 0)
+	if err != nil || res.RemovedFiles != 4 {
+		t.Fatalf("TrimTo(cutoff) = %+v, %v, want 4 files removed", res, err)
+	}
+}
+
+func TestTrimTo_sharedOutput(t *testing.T) {
+	t.Parallel()
+
+	c, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	// The 2 entries share the same output.
+	for i := 1; i <= 2; i++ {
+		if err = c.PutBytes(dummyID(i), []byte("data\n")); err != nil {
+			t.Fatalf("PutBytes: %v", err)
+		}
+	}
+
+	// Only the index of one entry is removed: the output is used by the other entry.
+	res, err := c.TrimTo(time.Time{}, entrySize+5)
+	if err != nil || res.RemovedFiles != 1 {
+		t.Fatalf("TrimTo(maxSize) = %+v, %v, want 1 file removed", res, err)
+	}
+
+	var found int
+	for i := 1; i <= 2; i++ {
+		if _, _, err := c.GetBytes(dummyID(i)); err == nil {
+			found++
+		}
+	}
+
+	if found != 1 {
+		t.Fatalf("TrimTo(maxSize): %d complete entries, want 1", found)
+	}
+}
@@ -0,0 +1,241 @@
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+const envGolangciLintCacheRemote = "GOLANGCI_LINT_CACHE_REMOTE"
+
+// remoteTimeout is the timeout of a request to the remote cache.
+const remoteTimeout = 30 * time.Second
+
+// maxRemoteEntrySize limits the size of the entries downloaded from the remote cache.
+const maxRemoteEntrySize = 512 << 20
+
+var errRemoteDisabled = errors.New("remote cache disabled")
+
+// errRemoteEntryTooLarge is a cache miss: the remote entry is larger than the maximum size.
+var errRemoteEntryTooLarge = fmt.Errorf("%w: remote entry too large", errMissing)
+
+// IsErrRemoteDisabled reports whether the error disabled the remote cache:
+// the local cache operation succeeded (or the entry is missing).
+func IsErrRemoteDisabled(err error) bool {
+	return errors.Is(err, errRemoteDisabled)
+}
+
+// IsErrRemoteEntryTooLarge reports whether the remote entry was ignored because of its size:
+// the error also satisfies IsErrMissing.
+func IsErrRemoteEntryTooLarge(err error) bool {
+	return errors.Is(err, errRemoteEntryTooLarge)
+}
+
+// A Backend is a shared storage of the outputs of the actions,
+// e.g. a remote cache shared by the CI runners.
+//
+// The local cache is always used:
+// the backend is read only on the local cache misses, and written on each put.
+// The action IDs include the hash salt (binary and configuration),
+// so only the same golangci-lint binary with the same configuration reuse the entries.
+type Backend interface {
+	// Get returns the output of the action, or an error satisfying IsErrMissing.
+	Get(id ActionID) ([]byte, error)
+
+	Put(id ActionID, data []byte) error
+}
+
+// HTTPBackend stores the outputs on an HTTP server:
+// GET <url>/<action ID> returns the output (404 if missing), PUT <url>/<action ID> stores it.
+//
+// The credentials of the URL are used for the basic authentication.
+type HTTPBackend struct {
+	url    string
+	client *http.Client
+
+	maxEntrySize int64
+}
+
+func NewHTTPBackend(rawURL string) (*HTTPBackend, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote cache URL: %w", err)
+	}
+
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("unsupported remote cache URL scheme %q: must be http or https", u.Scheme)
+	}
+
+	return &HTTPBackend{
+		url:          strings.TrimSuffix(u.String(), "/"),
+		client:       &http.Client{Timeout: remoteTimeout},
+		maxEntrySize: maxRemoteEntrySize,
+	}, nil
+}
+
+func (b *HTTPBackend) Get(id ActionID) ([]byte, error) {
+	resp, err := b.do(http.MethodGet, id, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// Reads one byte past the limit to detect the entries larger than the limit.
+		data, err := io.ReadAll(io.LimitReader(resp.Body, b.maxEntrySize+1))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read remote cache entry: %w", err)
+		}
+
+		if int64(len(data)) > b.maxEntrySize {
+			return nil, fmt.Errorf("%w: more than %d bytes", errRemoteEntryTooLarge, b.maxEntrySize)
+		}
+
+		return data, nil
+
+	case http.StatusNotFound:
+		return nil, errMissing
+
+	default:
+		return nil, fmt.Errorf("remote cache GET: unexpected status %s", resp.Status)
+	}
+}
+
+func (b *HTTPBackend) Put(id ActionID, data []byte) error {
+	resp, err := b.do(http.MethodPut, id, data)
+	if err != nil {
+		return err
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("remote cache PUT: unexpected status %s", resp.Status)
+	}
+
+	return nil
+}
+
+func (b *HTTPBackend) do(method string, id ActionID, data []byte) (*http.Response, error) {
+	var body io.Reader
+	if data != nil {
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), method, b.url+"/"+hex.EncodeToString(id[:]), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if data != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("remote cache %s: %w", method, err)
+	}
+
+	return resp, nil
+}
+
+// SetBackend sets the shared storage used in addition to the local cache.
+func (c *Cache) SetBackend(b Backend) {
+	c.backendMu.Lock()
+	defer c.backendMu.Unlock()
+
+	c.backend = b
+}
+
+func (c *Cache) getBackend() Backend {
+	c.backendMu.Lock()
+	defer c.backendMu.Unlock()
+
+	return c.backend
+}
+
+// disableBackend stops using the backend after an error:
+// the error is reported once, and the analysis continues with the local cache only.
+func (c *Cache) disableBackend(b Backend, err error) error {
+	c.backendMu.Lock()
+	defer c.backendMu.Unlock()
+
+	if c.backend != b {
+		// Already disabled by a concurrent call.
+		return nil
+	}
+
+	c.backend = nil
+
+	return fmt.Errorf("%w: %w", errRemoteDisabled, err)
+}
+
+func (c *Cache) getRemoteBytes(id ActionID) ([]byte, Entry, error) {
+	b := c.getBackend()
+	if b == nil {
+		return nil, Entry{}, errMissing
+	}
+
+	data, err := b.Get(id)
+	if err != nil {
+		if IsErrMissing(err) {
+			return nil, Entry{}, err
+		}
+
+		if err = c.disableBackend(b, err); err != nil {
+			return nil, Entry{}, err
+		}
+
+		return nil, Entry{}, errMissing
+	}
+
+	// Stored locally for the next runs.
+	out, size, err := c.Put(id, bytes.NewReader(data))
+	if err != nil {
+		return nil, Entry{}, err
+	}
+
+	return data, Entry{OutputID: out, Size: size, Time: c.now()}, nil
+}
+
+func (c *Cache) putRemoteBytes(id ActionID, data []byte) error {
+	b := c.getBackend()
+	if b == nil {
+		return nil
+	}
+
+	err := b.Put(id, data)
+	if err == nil {
+		return nil
+	}
+
+	return c.disableBackend(b, err)
+}
+
+// RemoteURL returns the URL of the remote cache (GOLANGCI_LINT_CACHE_REMOTE), without the credentials.
+func RemoteURL() string {
+	u, err := url.Parse(getRemoteURL())
+	if err != nil {
+		return ""
+	}
+
+	u.User = nil
+
+	return u.String()
+}
+
+func getRemoteURL() string {
+	return strings.TrimSpace(os.Getenv(envGolangciLintCacheRemote))
+}
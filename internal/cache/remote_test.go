@@ -0,0 +1,194 @@
+package cache
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// testRemoteServer is a stand-in for a remote cache server.
+type testRemoteServer struct {
+	mu      sync.Mutex
+	entries map[string][]byte
+	fail    bool
+}
+
+func (s *testRemoteServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.fail {
+		http.Error(w, "failure", http.StatusInternalServerError)
+		return
+	}
+
+	key := strings.TrimPrefix(r.URL.Path, "/cache/")
+
+	switch r.Method {
+	case http.MethodGet:
+		data, ok := s.entries[key]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(data)
+
+	case http.MethodPut:
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.entries[key] = data
+
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func TestHTTPBackend(t *testing.T) {
+	t.Parallel()
+
+	remote := &testRemoteServer{entries: map[string][]byte{}}
+
+	server := httptest.NewServer(remote)
+	defer server.Close()
+
+	newCache := func() *Cache {
+		c, err := Open(t.TempDir())
+		if err != nil {
+			t.Fatalf("Open: %v", err)
+		}
+
+		b, err := NewHTTPBackend(server.URL + "/cache/")
+		if err != nil {
+			t.Fatalf("NewHTTPBackend: %v", err)
+		}
+
+		c.SetBackend(b)
+
+		return c
+	}
+
+	// The first runner fills the remote cache.
+	c1 := newCache()
+	if err := c1.PutBytes(dummyID(1), []byte("data")); err != nil {
+		t.Fatalf("PutBytes: %v", err)
+	}
+
+	if len(remote.entries) != 1 {
+		t.Fatalf("remote entries: %d, want 1", len(remote.entries))
+	}
+
+	// Another runner uses it.
+	c2 := newCache()
+
+	data, _, err := c2.GetBytes(dummyID(1))
+	if err != nil || !bytes.Equal(data, []byte("data")) {
+		t.Fatalf("GetBytes(1) = %q, %v, want %q, nil", data, err, "data")
+	}
+
+	if _, _, err = c2.GetBytes(dummyID(2)); !IsErrMissing(err) {
+		t.Fatalf("GetBytes(2): %v, want missing", err)
+	}
+
+	// The entry is stored locally: the remote cache is no longer needed.
+	c2.SetBackend(nil)
+
+	data, _, err = c2.GetBytes(dummyID(1))
+	if err != nil || !bytes.Equal(data, []byte("data")) {
+		t.Fatalf("local GetBytes(1) = %q, %v, want %q, nil", data, err, "data")
+	}
+}
+
+func TestHTTPBackend_failure(t *testing.T) {
+	t.Parallel()
+
+	remote := &testRemoteServer{entries: map[string][]byte{}, fail: true}
+
+	server := httptest.NewServer(remote)
+	defer server.Close()
+
+	c, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	b, err := NewHTTPBackend(server.URL)
+	if err != nil {
+		t.Fatalf("NewHTTPBackend: %v", err)
+	}
+
+	c.SetBackend(b)
+
+	// The error is reported once, then the local cache is used alone.
+	if err = c.PutBytes(dummyID(1), []byte("data")); !IsErrRemoteDisabled(err) {
+		t.Fatalf("PutBytes: %v, want remote disabled error", err)
+	}
+
+	if err = c.PutBytes(dummyID(2), []byte("data")); err != nil {
+		t.Fatalf("PutBytes: %v", err)
+	}
+
+	data, _, err := c.GetBytes(dummyID(1))
+	if err != nil || !bytes.Equal(data, []byte("data")) {
+		t.Fatalf("GetBytes(1) = %q, %v, want %q, nil", data, err, "data")
+	}
+}
+
+func TestHTTPBackend_entryTooLarge(t *testing.T) {
+	t.Parallel()
+
+	remote := &testRemoteServer{entries: map[string][]byte{}}
+
+	server := httptest.NewServer(remote)
+	defer server.Close()
+
+	b, err := NewHTTPBackend(server.URL)
+	if err != nil {
+		t.Fatalf("NewHTTPBackend: %v", err)
+	}
+
+	b.maxEntrySize = 4
+
+	if err = b.Put(dummyID(1), []byte("data")); err != nil {
+		t.Fatalf("Put(1): %v", err)
+	}
+
+	if err = b.Put(dummyID(2), []byte("large")); err != nil {
+		t.Fatalf("Put(2): %v", err)
+	}
+
+	c, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatalf("Open: %v", err)
+	}
+
+	c.SetBackend(b)
+
+	data, _, err := c.GetBytes(dummyID(1))
+	if err != nil || !bytes.Equal(data, []byte("data")) {
+		t.Fatalf("GetBytes(1) = %q, %v, want %q, nil", data, err, "data")
+	}
+
+	// The entry larger than the limit is a miss: the backend is still used.
+	if _, _, err = c.GetBytes(dummyID(2)); !IsErrMissing(err) || !IsErrRemoteEntryTooLarge(err) {
+		t.Fatalf("GetBytes(2): %v, want entry too large error", err)
+	}
+
+	if c.getBackend() == nil {
+		t.Fatal("the backend is disabled")
+	}
+}
+
+func TestNewHTTPBackend_error(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewHTTPBackend("ftp://example.com"); err == nil {
+		t.Fatal("NewHTTPBackend: want error")
+	}
+}
@@ -0,0 +1,162 @@
+package pkgcache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"time"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/internal/cache"
+	"github.com/golangci/golangci-lint/internal/renameio"
+)
+
+// Kind of the cached data.
+type Kind string
+
+const (
+	KindFacts  Kind = "facts"
+	KindIssues Kind = "issues"
+)
+
+const statsFileName = "stats.json"
+
+// Meta describes the cached data of a package.
+type Meta struct {
+	Package string `json:"package"`
+	Kind    Kind   `json:"kind"`
+	// The linters (or the analyzers) producing the data.
+	// The data is stored by package: the entry contains the data of all these linters, even if some of them report nothing.
+	Linters []string `json:"linters,omitempty"`
+}
+
+// Entry is a cache entry with its metadata (nil if unknown).
+type Entry struct {
+	cache.EntryInfo
+
+	Meta *Meta
+}
+
+// KindStats counts the loads of a kind of data.
+type KindStats struct {
+	Hits   int `json:"hits"`
+	Misses int `json:"misses"`
+}
+
+// Stats are the loads of the last run.
+type Stats struct {
+	Time  time.Time           `json:"time"`
+	Kinds map[Kind]*KindStats `json:"kinds"`
+}
+
+// CountLoad records the load of the data of a package:
+// a hit if the data was found in the cache, a miss otherwise.
+func (c *Cache) CountLoad(kind Kind, hit bool) {
+	c.statsMu.Lock()
+	defer c.statsMu.Unlock()
+
+	if c.stats == nil {
+		c.stats = map[Kind]*KindStats{}
+	}
+
+	ks, ok := c.stats[kind]
+	if !ok {
+		ks = &KindStats{}
+		c.stats[kind] = ks
+	}
+
+	if hit {
+		ks.Hits++
+	} else {
+		ks.Misses++
+	}
+}
+
+// SaveStats stores the loads of the run into the cache directory (`golangci-lint cache stats`).
+func (c *Cache) SaveStats() error {
+	c.statsMu.Lock()
+	stats := Stats{Time: time.Now(), Kinds: c.stats}
+	c.statsMu.Unlock()
+
+	data, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+
+	return renameio.WriteFile(filepath.Join(cache.DefaultDir(), statsFileName), data, 0o666)
+}
+
+// LoadStats reads the loads of the last run.
+func LoadStats() (*Stats, error) {
+	data, err := os.ReadFile(filepath.Join(cache.DefaultDir(), statsFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	var stats Stats
+	if err = json.Unmarshal(data, &stats); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", statsFileName, err)
+	}
+
+	return &stats, nil
+}
+
+// Entries returns the entries of the cache with their metadata.
+func Entries(c *cache.Cache) ([]Entry, error) {
+	infos, err := c.Entries()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(infos))
+	for _, info := range infos {
+		entry := Entry{EntryInfo: info}
+
+		if len(info.Meta) != 0 {
+			var meta Meta
+			if json.Unmarshal(info.Meta, &meta) == nil {
+				entry.Meta = &meta
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// putMeta stores the metadata of the data of a package.
+// The linters are merged with the linters of the current metadata:
+// the same data (e.g. the facts) can be stored by the runs of different linters.
+func (c *Cache) putMeta(pkg *packages.Package, aID cache.ActionID, meta Meta) error {
+	meta.Package = pkg.PkgPath
+
+	c.ioSem <- struct{}{}
+	defer func() { <-c.ioSem }()
+
+	c.metaMu.Lock()
+	defer c.metaMu.Unlock()
+
+	linters := append([]string(nil), meta.Linters...)
+
+	if data, err := c.lowLevelCache.GetMeta(aID); err == nil && len(data) != 0 {
+		var current Meta
+		if json.Unmarshal(data, &current) == nil && current.Package == meta.Package && current.Kind == meta.Kind {
+			linters = append(linters, current.Linters...)
+		}
+	}
+
+	sort.Strings(linters)
+	meta.Linters = slices.Compact(linters)
+
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+
+	return c.lowLevelCache.PutMeta(aID, data)
+}
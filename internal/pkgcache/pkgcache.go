@@ -17,6 +17,8 @@ import (
 	"github.com/golangci/golangci-lint/pkg/timeutils"
 )
 
+var debugf = logutils.Debug(logutils.DebugKeyPkgCache)
+
 type HashMode int
 
 const (
@@ -31,8 +33,26 @@ type Cache struct {
 	lowLevelCache *cache.Cache
 	pkgHashes     sync.Map
 	sw            *timeutils.Stopwatch
-	log           logutils.Log  // not used now, but may be needed for future debugging purposes
+	log           logutils.Log
 	ioSem         chan struct{} // semaphore limiting parallel IO
+
+	// Loads of the current run by kind.
+	stats   map[Kind]*KindStats
+	statsMu sync.Mutex
+
+	// Optional in-memory layer used by the long-lived processes:
+	// the last data of each package by key.
+	// The packages are identified by their IDs: a package and its test variants have the same path.
+	memory   map[string]memoryEntry
+	memoryMu sync.Mutex
+
+	// Serializes the updates of the metadata (putMeta).
+	metaMu sync.Mutex
+}
+
+type memoryEntry struct {
+	aID  cache.ActionID
+	data []byte
 }
 
 func NewCache(sw *timeutils.Stopwatch, log logutils.Log) (*Cache, error) {
@@ -48,13 +68,38 @@ func NewCache(sw *timeutils.Stopwatch, log logutils.Log) (*Cache, error) {
 	}, nil
 }
 
+// EnableMemoryLayer keeps the data in memory in addition to the low-level cache.
+// Only the last data of a package is kept: the data of the packages (and their dependencies) that didn't change
+// are read from memory.
+func (c *Cache) EnableMemoryLayer() {
+	c.memoryMu.Lock()
+	defer c.memoryMu.Unlock()
+
+	if c.memory == nil {
+		c.memory = map[string]memoryEntry{}
+	}
+}
+
+// ResetPackageHashes forgets the computed package hashes, and the hashes of their files.
+// It must be called when the files are changed inside a long-lived process.
+func (c *Cache) ResetPackageHashes() {
+	c.pkgHashes.Range(func(key, _ any) bool {
+		c.pkgHashes.Delete(key)
+		return true
+	})
+
+	cache.ResetFileHashes()
+}
+
 func (c *Cache) Trim() {
 	c.sw.TrackStage("trim", func() {
 		c.lowLevelCache.Trim()
 	})
 }
 
-func (c *Cache) Put(pkg *packages.Package, mode HashMode, key string, data any) error {
+// Put stores the data of the package.
+// The metadata describes the data for the inspection of the cache (`golangci-lint cache`).
+func (c *Cache) Put(pkg *packages.Package, mode HashMode, key string, data any, meta Meta) error {
 	var err error
 	buf := &bytes.Buffer{}
 	c.sw.TrackStage("gob", func() {
@@ -79,15 +124,25 @@ func (c *Cache) Put(pkg *packages.Package, mode HashMode, key string, data any)
 	if err != nil {
 		return fmt.Errorf("failed to calculate package %s action id: %w", pkg.Name, err)
 	}
+	c.putMemory(pkg, key, aID, buf.Bytes())
+
 	c.ioSem <- struct{}{}
 	c.sw.TrackStage("cache io", func() {
 		err = c.lowLevelCache.PutBytes(aID, buf.Bytes())
 	})
 	<-c.ioSem
+	if cache.IsErrRemoteDisabled(err) {
+		c.log.Warnf("%s", err)
+		err = nil
+	}
 	if err != nil {
 		return fmt.Errorf("failed to save data to low-level cache by key %s for package %s: %w", key, pkg.Name, err)
 	}
 
+	if err = c.putMeta(pkg, aID, meta); err != nil {
+		return fmt.Errorf("failed to save metadata to low-level cache by key %s for package %s: %w", key, pkg.Name, err)
+	}
+
 	return nil
 }
 
@@ -110,17 +165,28 @@ func (c *Cache) Get(pkg *packages.Package, mode HashMode, key string, data any)
 		return fmt.Errorf("failed to calculate package %s action id: %w", pkg.Name, err)
 	}
 
-	var b []byte
-	c.ioSem <- struct{}{}
-	c.sw.TrackStage("cache io", func() {
-		b, _, err = c.lowLevelCache.GetBytes(aID)
-	})
-	<-c.ioSem
-	if err != nil {
-		if cache.IsErrMissing(err) {
+	b, ok := c.getMemory(pkg, key, aID)
+	if !ok {
+		c.ioSem <- struct{}{}
+		c.sw.TrackStage("cache io", func() {
+			b, _, err = c.lowLevelCache.GetBytes(aID)
+		})
+		<-c.ioSem
+		if cache.IsErrRemoteDisabled(err) {
+			c.log.Warnf("%s", err)
 			return ErrMissing
 		}
-		return fmt.Errorf("failed to get data from low-level cache by key %s for package %s: %w", key, pkg.Name, err)
+		if err != nil {
+			if cache.IsErrRemoteEntryTooLarge(err) {
+				debugf("Ignored the remote cache entry by key %s for package %s: %s", key, pkg.Name, err)
+			}
+			if cache.IsErrMissing(err) {
+				return ErrMissing
+			}
+			return fmt.Errorf("failed to get data from low-level cache by key %s for package %s: %w", key, pkg.Name, err)
+		}
+
+		c.putMemory(pkg, key, aID, b)
 	}
 
 	c.sw.TrackStage("gob", func() {
@@ -133,6 +199,33 @@ func (c *Cache) Get(pkg *packages.Package, mode HashMode, key string, data any)
 	return nil
 }
 
+func (c *Cache) getMemory(pkg *packages.Package, key string, aID cache.ActionID) ([]byte, bool) {
+	c.memoryMu.Lock()
+	defer c.memoryMu.Unlock()
+
+	if c.memory == nil {
+		return nil, false
+	}
+
+	entry, ok := c.memory[pkg.ID+"\x00"+key]
+	if !ok || entry.aID != aID {
+		return nil, false
+	}
+
+	return entry.data, true
+}
+
+func (c *Cache) putMemory(pkg *packages.Package, key string, aID cache.ActionID, data []byte) {
+	c.memoryMu.Lock()
+	defer c.memoryMu.Unlock()
+
+	if c.memory == nil {
+		return
+	}
+
+	c.memory[pkg.ID+"\x00"+key] = memoryEntry{aID: aID, data: data}
+}
+
 func (c *Cache) pkgActionID(pkg *packages.Package, mode HashMode) (cache.ActionID, error) {
 	hash, err := c.packageHash(pkg, mode)
 	if err != nil {
@@ -171,6 +264,9 @@ func (c *Cache) packageHash(pkg *packages.Package, mode HashMode) (string, error
 	}
 
 	fmt.Fprintf(key, "pkgpath %s\n", pkg.PkgPath)
+
+	// The paths are absolute: the cached results contain the absolute paths of the files,
+	// the entries of the remote cache are only shared between the machines using the same paths.
 	for _, f := range pkg.CompiledGoFiles {
 		c.ioSem <- struct{}{}
 		h, fErr := cache.FileHash(f)
@@ -0,0 +1,9 @@
+// Package jsonschema contains the JSON schemas of the configuration and of the outputs.
+package jsonschema
+
+import _ "embed"
+
+// Next is the JSON schema of the configuration of the current sources (golangci.next.jsonschema.json).
+//
+//go:embed golangci.next.jsonschema.json
+var Next []byte
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+type baselineCommand struct {
+	cmd *cobra.Command
+}
+
+func newBaselineCommand(logger logutils.Log, info BuildInfo) *baselineCommand {
+	c := &baselineCommand{}
+
+	baselineCmd := &cobra.Command{
+		Use:   "baseline",
+		Short: "Baseline of the existing issues",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+	}
+
+	// The creation runs the linters exactly like the run command (same flags and configuration),
+	// but the issues are written into the baseline file instead of being printed.
+	createCmd := newRunCommand(logger, info)
+	createCmd.opts.CreateBaseline = true
+	createCmd.cmd.Use = "create"
+	createCmd.cmd.Short = "Create the baseline file from the current issues"
+	createCmd.cmd.Long = "Create the baseline file (`issues.baseline`, default: " + config.DefaultBaselinePath + ") " +
+		"from the current issues.\nThe issues recorded in the baseline file are hidden by the run command."
+
+	baselineCmd.AddCommand(createCmd.cmd)
+
+	c.cmd = baselineCmd
+
+	return c
+}
+
+// setupBaselineCreation configures the run to record all the current issues into the baseline file.
+func (c *runCommand) setupBaselineCreation() {
+	if c.cfg.Issues.Baseline == "" {
+		c.cfg.Issues.Baseline = config.DefaultBaselinePath
+	}
+
+	c.cfg.Issues.NeedBaselineUpdate = true
+
+	// The files must not be modified.
+	c.cfg.Issues.NeedFix = false
+	c.cfg.Issues.FixOutput = ""
+
+	// Nothing is printed.
+	c.cfg.Output.Stream = false
+}
@@ -0,0 +1,237 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+)
+
+// ratchetBudgets lowers the budgets to the current counts of issues (`issues.ratchet-budgets`),
+// in the configuration files defining them: the configuration file, or the configuration files it extends.
+func (c *runCommand) ratchetBudgets() error {
+	counts := c.budgets.Counts()
+
+	sources := c.cfg.ListSources("issues.budgets")
+	if len(sources) != len(counts) {
+		return errors.New("the budgets don't match the configuration")
+	}
+
+	enabledLinters, err := c.dbManager.GetEnabledLintersMap()
+	if err != nil {
+		return err
+	}
+
+	narrowed := narrowedLinters(c.cmd.Flags())
+
+	positions := c.cfg.ListPositions("issues.budgets")
+
+	var lowered []int
+
+	for i, count := range counts {
+		if count >= c.cfg.Issues.Budgets[i].Max {
+			continue
+		}
+
+		if reason := budgetPartialReason(c.cfg.Issues.Budgets[i], enabledLinters, narrowed); reason != "" {
+			c.log.Warnf("Budget %s not lowered: %s", positions[i], reason)
+			continue
+		}
+
+		if sources[i].Path == "" {
+			return fmt.Errorf("the budget #%d is not defined in a configuration file", i)
+		}
+
+		lowered = append(lowered, i)
+	}
+
+	if len(lowered) == 0 {
+		return nil
+	}
+
+	// The counts of the budgets of each configuration file: math.MaxInt keeps the current value.
+	fileCounts := map[string][]int{}
+
+	var paths []string
+
+	for _, source := range sources {
+		if source.Path == "" {
+			continue
+		}
+
+		if _, ok := fileCounts[source.Path]; !ok {
+			paths = append(paths, source.Path)
+		}
+
+		for len(fileCounts[source.Path]) <= source.Index {
+			fileCounts[source.Path] = append(fileCounts[source.Path], math.MaxInt)
+		}
+	}
+
+	for _, i := range lowered {
+		source := sources[i]
+		fileCounts[source.Path][source.Index] = min(fileCounts[source.Path][source.Index], counts[i])
+	}
+
+	for _, path := range paths {
+		if !slices.ContainsFunc(fileCounts[path], func(count int) bool { return count != math.MaxInt }) {
+			continue
+		}
+
+		if err := lowerFileBudgets(path, fileCounts[path]); err != nil {
+			return err
+		}
+	}
+
+	for _, i := range lowered {
+		c.log.Infof("Budget %s lowered from %d to %d", positions[i], c.cfg.Issues.Budgets[i].Max, counts[i])
+	}
+
+	return nil
+}
+
+// lowerFileBudgets lowers the budgets of a configuration file (see lowerBudgets).
+func lowerFileBudgets(path string, counts []int) error {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml":
+	default:
+		return fmt.Errorf("the budgets can only be lowered in a YAML configuration file: %s", path)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	content, err = lowerBudgets(content, counts)
+	if err != nil {
+		return fmt.Errorf("can't lower the budgets of %s: %w", path, err)
+	}
+
+	return os.WriteFile(path, content, 0o644)
+}
+
+// partialRunReason returns why the issues of the run are not all the issues of the project, or an empty string:
+// the budgets can't be lowered to the counts of the issues of a partial run.
+func partialRunReason(cfg *config.Config, args []string) string {
+	switch {
+	case slices.ContainsFunc(args, func(arg string) bool { return arg != "./..." }):
+		return "only some packages are analyzed"
+
+	case cfg.Issues.Diff || cfg.Issues.DiffFromRevision != "" || cfg.Issues.DiffPatchFilePath != "":
+		return "only the new issues are analyzed (`new`, `new-from-rev`, `new-from-patch`)"
+
+	// The issues of the baseline are hidden, or only reported as known issues (`fail-on-new-only`).
+	case cfg.Issues.Baseline != "":
+		return "the issues are compared with a baseline (`baseline`)"
+
+	default:
+		return ""
+	}
+}
+
+// budgetPartialReason returns why the issues of the budget are not all counted by the run, or an empty string:
+// the linters of the budget, or all the linters enabled by the configuration for a budget without linters, must have run.
+func budgetPartialReason(budget config.Budget, enabledLinters map[string]*linter.Config, narrowed bool) string {
+	if len(budget.Linters) == 0 {
+		if narrowed {
+			return "the enabled linters are restricted by the command line (`--enable-only`, `--disable`, `--fast`, `--presets`)"
+		}
+
+		return ""
+	}
+
+	for _, name := range budget.Linters {
+		if _, ok := enabledLinters[name]; !ok {
+			return fmt.Sprintf("the linter %s didn't run", name)
+		}
+	}
+
+	return ""
+}
+
+// narrowedLinters returns true if the flags restrict the linters enabled by the configuration.
+func narrowedLinters(fs *pflag.FlagSet) bool {
+	for _, name := range []string{"enable-only", "disable", "disable-all", "fast", "presets"} {
+		if fs.Changed(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lowerBudgets sets the `max` of the budgets (`issues.budgets`) to the counts lower than the current values.
+// Only the values are replaced: the rest of the configuration file is unchanged.
+func lowerBudgets(content []byte, counts []int) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, err
+	}
+
+	if len(doc.Content) == 0 {
+		return nil, errors.New("empty configuration")
+	}
+
+	budgets := yamlMappingValue(yamlMappingValue(doc.Content[0], "issues"), "budgets")
+	if budgets == nil || budgets.Kind != yaml.SequenceNode || len(budgets.Content) != len(counts) {
+		return nil, errors.New("the budgets don't match the configuration")
+	}
+
+	lines := bytes.SplitAfter(content, []byte("\n"))
+
+	// From the last budget: the replacements don't move the positions of the previous values.
+	for i := len(counts) - 1; i >= 0; i-- {
+		value := yamlMappingValue(budgets.Content[i], "max")
+		if value == nil || value.Kind != yaml.ScalarNode {
+			return nil, fmt.Errorf("no max in the budget #%d", i)
+		}
+
+		current, err := strconv.Atoi(value.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid max in the budget #%d: %w", i, err)
+		}
+
+		if counts[i] >= current {
+			continue
+		}
+
+		// The positions of the nodes are 1-based.
+		line := lines[value.Line-1]
+		start := value.Column - 1
+
+		if !bytes.HasPrefix(line[start:], []byte(value.Value)) {
+			return nil, fmt.Errorf("unsupported format of max in the budget #%d", i)
+		}
+
+		lines[value.Line-1] = slices.Concat(line[:start], []byte(strconv.Itoa(counts[i])), line[start+len(value.Value):])
+	}
+
+	return bytes.Join(lines, nil), nil
+}
+
+// yamlMappingValue returns the value of the key, nil if the node is not a mapping or the key doesn't exist.
+func yamlMappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
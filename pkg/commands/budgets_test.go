@@ -0,0 +1,224 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+)
+
+func Test_lowerBudgets(t *testing.T) {
+	content := `issues:
+  budgets:
+    # The legacy code.
+    - path: legacy/**
+      max: 12 # 2024-06
+    - linters: [errcheck]
+      max: 3
+    - linters:
+        - gosec
+      max: 7
+`
+
+	lowered, err := lowerBudgets([]byte(content), []int{10, 4, 7})
+	require.NoError(t, err)
+
+	expected := `issues:
+  budgets:
+    # The legacy code.
+    - path: legacy/**
+      max: 10 # 2024-06
+    - linters: [errcheck]
+      max: 3
+    - linters:
+        - gosec
+      max: 7
+`
+
+	assert.Equal(t, expected, string(lowered))
+}
+
+func Test_lowerBudgets_error(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		content  string
+		counts   []int
+		expected string
+	}{
+		{
+			desc:     "no budgets",
+			content:  "run:\n  timeout: 5m\n",
+			counts:   []int{1},
+			expected: "the budgets don't match the configuration",
+		},
+		{
+			desc:     "different number of budgets",
+			content:  "issues:\n  budgets:\n    - path: a/**\n      max: 2\n",
+			counts:   []int{1, 1},
+			expected: "the budgets don't match the configuration",
+		},
+		{
+			desc:     "no max",
+			content:  "issues:\n  budgets:\n    - path: a/**\n",
+			counts:   []int{1},
+			expected: "no max in the budget #0",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := lowerBudgets([]byte(test.content), test.counts)
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func Test_partialRunReason(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		issues   config.Issues
+		args     []string
+		expected string
+	}{
+		{
+			desc: "whole project",
+		},
+		{
+			desc: "all the packages",
+			args: []string{"./..."},
+		},
+		{
+			desc:     "some packages",
+			args:     []string{"./pkg/..."},
+			expected: "only some packages are analyzed",
+		},
+		{
+			desc:     "new-from-rev",
+			issues:   config.Issues{DiffFromRevision: "HEAD~1"},
+			expected: "only the new issues are analyzed (`new`, `new-from-rev`, `new-from-patch`)",
+		},
+		{
+			desc:     "new-from-patch",
+			issues:   config.Issues{DiffPatchFilePath: "changes.patch"},
+			expected: "only the new issues are analyzed (`new`, `new-from-rev`, `new-from-patch`)",
+		},
+		{
+			desc:     "baseline",
+			issues:   config.Issues{Baseline: ".golangci-baseline.json"},
+			expected: "the issues are compared with a baseline (`baseline`)",
+		},
+		{
+			desc:     "fail-on-new-only with a baseline",
+			issues:   config.Issues{Baseline: ".golangci-baseline.json", FailOnNewOnly: true},
+			expected: "the issues are compared with a baseline (`baseline`)",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, partialRunReason(&config.Config{Issues: test.issues}, test.args))
+		})
+	}
+}
+
+func Test_budgetPartialReason(t *testing.T) {
+	enabledLinters := map[string]*linter.Config{"govet": nil, "errcheck": nil}
+
+	testCases := []struct {
+		desc     string
+		budget   config.Budget
+		narrowed bool
+		expected string
+	}{
+		{
+			desc:   "linters ran",
+			budget: config.Budget{Linters: []string{"govet", "errcheck"}, Max: 1},
+		},
+		{
+			desc:     "linter not run",
+			budget:   config.Budget{Linters: []string{"govet", "gosec"}, Max: 1},
+			expected: "the linter gosec didn't run",
+		},
+		{
+			desc:     "linters ran with restricted linters",
+			budget:   config.Budget{Linters: []string{"errcheck"}, Max: 1},
+			narrowed: true,
+		},
+		{
+			desc:   "path",
+			budget: config.Budget{Path: "legacy/**", Max: 1},
+		},
+		{
+			desc:     "path with restricted linters",
+			budget:   config.Budget{Path: "legacy/**", Max: 1},
+			narrowed: true,
+			expected: "the enabled linters are restricted by the command line (`--enable-only`, `--disable`, `--fast`, `--presets`)",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, budgetPartialReason(test.budget, enabledLinters, test.narrowed))
+		})
+	}
+}
+
+func Test_narrowedLinters(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		args     []string
+		expected bool
+	}{
+		{
+			desc: "no flags",
+		},
+		{
+			desc: "enable",
+			args: []string{"-E", "gosec"},
+		},
+		{
+			desc:     "enable-only",
+			args:     []string{"--enable-only", "govet"},
+			expected: true,
+		},
+		{
+			desc:     "disable",
+			args:     []string{"-D", "errcheck"},
+			expected: true,
+		},
+		{
+			desc:     "fast",
+			args:     []string{"--fast"},
+			expected: true,
+		},
+		{
+			desc:     "presets",
+			args:     []string{"-p", "bugs"},
+			expected: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			fs := pflag.NewFlagSet("run", pflag.ContinueOnError)
+			setupLintersFlagSet(viper.New(), fs)
+
+			require.NoError(t, fs.Parse(test.args))
+
+			assert.Equal(t, test.expected, narrowedLinters(fs))
+		})
+	}
+}
@@ -1,19 +1,43 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
 
+	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 
 	"github.com/golangci/golangci-lint/internal/cache"
+	"github.com/golangci/golangci-lint/internal/pkgcache"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 )
 
+type cacheTrimOptions struct {
+	OlderThan time.Duration
+	MaxSize   string
+}
+
+type cacheInvalidateOptions struct {
+	Linters  []string
+	Packages []string
+}
+
 type cacheCommand struct {
 	cmd *cobra.Command
+
+	trimOpts       cacheTrimOptions
+	invalidateOpts cacheInvalidateOptions
 }
 
 func newCacheCommand() *cacheCommand {
@@ -28,6 +52,40 @@ func newCacheCommand() *cacheCommand {
 		},
 	}
 
+	trimCmd := &cobra.Command{
+		Use:               "trim",
+		Short:             "Remove the least recently used cache entries",
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE:              c.executeTrim,
+	}
+
+	trimFlagSet := trimCmd.Flags()
+	trimFlagSet.DurationVar(&c.trimOpts.OlderThan, "older-than", 0,
+		color.GreenString("Remove the entries unused for this duration (ex: 168h)"))
+	trimFlagSet.StringVar(&c.trimOpts.MaxSize, "max-size", "",
+		color.GreenString("Remove the least recently used entries until the cache size is lower (ex: 500MiB)"))
+
+	invalidateCmd := &cobra.Command{
+		Use:   "invalidate",
+		Short: "Remove the cache entries of linters or packages",
+		Long: `Remove the cache entries of linters or packages.
+
+The entries are stored by package: the issues of a package are stored in one entry for all the linters running together
+(e.g. all the linters based on go/analysis), and the facts of an analyzer are shared by all the linters using it.
+Removing the entries of a linter removes the cached results of these other linters on the same packages:
+they are analyzed again on the next run.`,
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE:              c.executeInvalidate,
+	}
+
+	invalidateFlagSet := invalidateCmd.Flags()
+	invalidateFlagSet.StringSliceVar(&c.invalidateOpts.Linters, "linter", nil,
+		color.GreenString("Remove the entries (facts and issues) of the packages analyzed by the linter"))
+	invalidateFlagSet.StringSliceVar(&c.invalidateOpts.Packages, "package", nil,
+		color.GreenString("Remove the entries of the packages matching the pattern (ex: example.com/foo/...)"))
+
 	cacheCmd.AddCommand(
 		&cobra.Command{
 			Use:               "clean",
@@ -43,6 +101,15 @@ func newCacheCommand() *cacheCommand {
 			ValidArgsFunction: cobra.NoFileCompletions,
 			Run:               c.executeStatus,
 		},
+		&cobra.Command{
+			Use:               "stats",
+			Short:             "Show cache statistics",
+			Args:              cobra.NoArgs,
+			ValidArgsFunction: cobra.NoFileCompletions,
+			RunE:              c.executeStats,
+		},
+		trimCmd,
+		invalidateCmd,
 	)
 
 	c.cmd = cacheCmd
@@ -68,6 +135,283 @@ func (*cacheCommand) executeStatus(_ *cobra.Command, _ []string) {
 	if err == nil {
 		_, _ = fmt.Fprintf(logutils.StdOut, "Size: %s\n", fsutils.PrettifyBytesCount(cacheSizeBytes))
 	}
+
+	if remoteURL := cache.RemoteURL(); remoteURL != "" {
+		_, _ = fmt.Fprintf(logutils.StdOut, "Remote: %s\n", remoteURL)
+	}
+}
+
+func (*cacheCommand) executeStats(_ *cobra.Command, _ []string) error {
+	lowLevelCache, err := cache.Default()
+	if err != nil {
+		return fmt.Errorf("failed to open cache: %w", err)
+	}
+
+	entries, err := pkgcache.Entries(lowLevelCache)
+	if err != nil {
+		return fmt.Errorf("failed to read cache entries: %w", err)
+	}
+
+	stats, err := pkgcache.LoadStats()
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	printCacheStats(logutils.StdOut, entries, stats, time.Now())
+
+	return nil
+}
+
+func (c *cacheCommand) executeTrim(_ *cobra.Command, _ []string) error {
+	if c.trimOpts.OlderThan <= 0 && c.trimOpts.MaxSize == "" {
+		return errors.New("--older-than or --max-size is required")
+	}
+
+	var maxSize int64
+	if c.trimOpts.MaxSize != "" {
+		var err error
+		maxSize, err = parseBytesCount(c.trimOpts.MaxSize)
+		if err != nil {
+			return fmt.Errorf("invalid --max-size: %w", err)
+		}
+	}
+
+	var cutoff time.Time
+	if c.trimOpts.OlderThan > 0 {
+		cutoff = time.Now().Add(-c.trimOpts.OlderThan)
+	}
+
+	lowLevelCache, err := cache.Default()
+	if err != nil {
+		return fmt.Errorf("failed to open cache: %w", err)
+	}
+
+	res, err := lowLevelCache.TrimTo(cutoff, maxSize)
+	if err != nil {
+		return fmt.Errorf("failed to trim cache: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(logutils.StdOut, "Removed %d files (%s)\n", res.RemovedFiles, fsutils.PrettifyBytesCount(res.RemovedBytes))
+
+	return nil
+}
+
+func (c *cacheCommand) executeInvalidate(_ *cobra.Command, _ []string) error {
+	if len(c.invalidateOpts.Linters) == 0 && len(c.invalidateOpts.Packages) == 0 {
+		return errors.New("--linter or --package is required")
+	}
+
+	lowLevelCache, err := cache.Default()
+	if err != nil {
+		return fmt.Errorf("failed to open cache: %w", err)
+	}
+
+	entries, err := pkgcache.Entries(lowLevelCache)
+	if err != nil {
+		return fmt.Errorf("failed to read cache entries: %w", err)
+	}
+
+	var removed int
+	for _, entry := range entries {
+		if !c.matchEntry(&entry) {
+			continue
+		}
+
+		if err := lowLevelCache.Remove(entry.ID); err != nil {
+			return fmt.Errorf("failed to remove cache entry: %w", err)
+		}
+
+		removed++
+	}
+
+	_, _ = fmt.Fprintf(logutils.StdOut, "Removed %d entries\n", removed)
+
+	return nil
+}
+
+// matchEntry reports whether the entry matches all the filters.
+// The entries without metadata never match.
+func (c *cacheCommand) matchEntry(entry *pkgcache.Entry) bool {
+	if entry.Meta == nil {
+		return false
+	}
+
+	if len(c.invalidateOpts.Linters) != 0 &&
+		!slices.ContainsFunc(entry.Meta.Linters, func(name string) bool {
+			return slices.Contains(c.invalidateOpts.Linters, name)
+		}) {
+		return false
+	}
+
+	if len(c.invalidateOpts.Packages) != 0 &&
+		!slices.ContainsFunc(c.invalidateOpts.Packages, func(pattern string) bool {
+			return matchPackagePattern(pattern, entry.Meta.Package)
+		}) {
+		return false
+	}
+
+	return true
+}
+
+func printCacheStats(w io.Writer, entries []pkgcache.Entry, stats *pkgcache.Stats, now time.Time) {
+	type counter struct {
+		entries int
+		size    int64
+	}
+
+	var total counter
+	perKind := map[string]*counter{}
+	perLinter := map[string]*counter{}
+
+	ageBuckets := []struct {
+		name   string
+		maxAge time.Duration
+		count  int
+	}{
+		{name: "< 1h", maxAge: time.Hour},
+		{name: "< 1d", maxAge: 24 * time.Hour},
+		{name: "< 7d", maxAge: 7 * 24 * time.Hour},
+		{name: "< 30d", maxAge: 30 * 24 * time.Hour},
+		{name: ">= 30d", maxAge: -1},
+	}
+
+	add := func(m map[string]*counter, key string, entry *pkgcache.Entry) {
+		if m[key] == nil {
+			m[key] = &counter{}
+		}
+
+		m[key].entries++
+		m[key].size += entry.Size
+	}
+
+	for i := range entries {
+		entry := &entries[i]
+
+		total.entries++
+		total.size += entry.Size
+
+		if entry.Meta == nil {
+			add(perKind, "unknown", entry)
+		} else {
+			add(perKind, string(entry.Meta.Kind), entry)
+
+			for _, name := range entry.Meta.Linters {
+				add(perLinter, name, entry)
+			}
+		}
+
+		age := now.Sub(entry.Used)
+		for j := range ageBuckets {
+			if ageBuckets[j].maxAge < 0 || age < ageBuckets[j].maxAge {
+				ageBuckets[j].count++
+				break
+			}
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	printCounters := func(title string, m map[string]*counter) {
+		_, _ = fmt.Fprintf(tw, "\n%s:\n", title)
+
+		keys := make([]string, 0, len(m))
+		for key := range m {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			_, _ = fmt.Fprintf(tw, "  %s\t%d\t%s\n", key, m[key].entries, fsutils.PrettifyBytesCount(m[key].size))
+		}
+	}
+
+	_, _ = fmt.Fprintf(tw, "Entries: %d (%s)\n", total.entries, fsutils.PrettifyBytesCount(total.size))
+
+	printCounters("Entries by kind", perKind)
+	printCounters("Entries by linter", perLinter)
+
+	_, _ = fmt.Fprintf(tw, "\nEntries by last use:\n")
+	for _, bucket := range ageBuckets {
+		_, _ = fmt.Fprintf(tw, "  %s\t%d\n", bucket.name, bucket.count)
+	}
+
+	if stats != nil {
+		_, _ = fmt.Fprintf(tw, "\nLast run (%s):\n", stats.Time.Format(time.RFC3339))
+
+		kinds := make([]string, 0, len(stats.Kinds))
+		for kind := range stats.Kinds {
+			kinds = append(kinds, string(kind))
+		}
+
+		sort.Strings(kinds)
+
+		for _, kind := range kinds {
+			ks := stats.Kinds[pkgcache.Kind(kind)]
+
+			var ratio float64
+			if loads := ks.Hits + ks.Misses; loads > 0 {
+				ratio = float64(ks.Hits) * 100 / float64(loads)
+			}
+
+			_, _ = fmt.Fprintf(tw, "  %s\t%d hits\t%d misses\t%.1f%% hit ratio\n", kind, ks.Hits, ks.Misses, ratio)
+		}
+	}
+
+	_ = tw.Flush()
+}
+
+// matchPackagePattern matches a package path with a pattern:
+// `example.com/foo/...` matches the package and its sub-packages, other patterns use the path.Match syntax.
+func matchPackagePattern(pattern, pkgPath string) bool {
+	if pattern == "..." {
+		return true
+	}
+
+	if prefix, ok := strings.CutSuffix(pattern, "/..."); ok {
+		return pkgPath == prefix || strings.HasPrefix(pkgPath, prefix+"/")
+	}
+
+	matched, err := path.Match(pattern, pkgPath)
+
+	return err == nil && matched
+}
+
+// parseBytesCount parses a size: a number of bytes with an optional unit (KiB, MiB, GiB).
+func parseBytesCount(s string) (int64, error) {
+	// The longest suffixes first.
+	units := []struct {
+		suffix     string
+		multiplier int64
+	}{
+		{suffix: "gib", multiplier: 1 << 30},
+		{suffix: "mib", multiplier: 1 << 20},
+		{suffix: "kib", multiplier: 1 << 10},
+		{suffix: "gb", multiplier: 1 << 30},
+		{suffix: "mb", multiplier: 1 << 20},
+		{suffix: "kb", multiplier: 1 << 10},
+		{suffix: "g", multiplier: 1 << 30},
+		{suffix: "m", multiplier: 1 << 20},
+		{suffix: "k", multiplier: 1 << 10},
+		{suffix: "b", multiplier: 1},
+	}
+
+	value := strings.ToLower(strings.TrimSpace(s))
+	multiplier := int64(1)
+
+	for _, unit := range units {
+		if number, ok := strings.CutSuffix(value, unit.suffix); ok {
+			value, multiplier = strings.TrimSpace(number), unit.multiplier
+			break
+		}
+	}
+
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+
+	return int64(n * float64(multiplier)), nil
 }
 
 func dirSizeBytes(path string) (int64, error) {
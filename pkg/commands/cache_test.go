@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/internal/cache"
+	"github.com/golangci/golangci-lint/internal/pkgcache"
+)
+
+func Test_parseBytesCount(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected int64
+	}{
+		{value: "1024", expected: 1024},
+		{value: "10b", expected: 10},
+		{value: "2KiB", expected: 2048},
+		{value: "500MiB", expected: 500 << 20},
+		{value: "500MB", expected: 500 << 20},
+		{value: "1.5 GiB", expected: 3 << 29},
+		{value: "2g", expected: 2 << 30},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.value, func(t *testing.T) {
+			t.Parallel()
+
+			n, err := parseBytesCount(test.value)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, n)
+		})
+	}
+}
+
+func Test_parseBytesCount_error(t *testing.T) {
+	for _, value := range []string{"", "MiB", "-1", "10TiB"} {
+		_, err := parseBytesCount(value)
+		require.Error(t, err, value)
+	}
+}
+
+func Test_matchPackagePattern(t *testing.T) {
+	testCases := []struct {
+		pattern  string
+		pkgPath  string
+		expected bool
+	}{
+		{pattern: "example.com/foo", pkgPath: "example.com/foo", expected: true},
+		{pattern: "example.com/foo", pkgPath: "example.com/foo/bar", expected: false},
+		{pattern: "example.com/foo/...", pkgPath: "example.com/foo", expected: true},
+		{pattern: "example.com/foo/...", pkgPath: "example.com/foo/bar/baz", expected: true},
+		{pattern: "example.com/foo/...", pkgPath: "example.com/foobar", expected: false},
+		{pattern: "example.com/*/bar", pkgPath: "example.com/foo/bar", expected: true},
+		{pattern: "...", pkgPath: "example.com/foo", expected: true},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.pattern+" "+test.pkgPath, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, matchPackagePattern(test.pattern, test.pkgPath))
+		})
+	}
+}
+
+func Test_printCacheStats(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	entries := []pkgcache.Entry{
+		{
+			EntryInfo: cache.EntryInfo{Entry: cache.Entry{Size: 1024}, Used: now.Add(-time.Minute)},
+			Meta:      &pkgcache.Meta{Package: "example.com/foo", Kind: pkgcache.KindIssues, Linters: []string{"errcheck", "govet"}},
+		},
+		{
+			EntryInfo: cache.EntryInfo{Entry: cache.Entry{Size: 2048}, Used: now.Add(-48 * time.Hour)},
+			Meta:      &pkgcache.Meta{Package: "example.com/foo", Kind: pkgcache.KindFacts, Linters: []string{"govet"}},
+		},
+		{
+			EntryInfo: cache.EntryInfo{Entry: cache.Entry{Size: 10}, Used: now.Add(-60 * 24 * time.Hour)},
+		},
+	}
+
+	stats := &pkgcache.Stats{
+		Time: now,
+		Kinds: map[pkgcache.Kind]*pkgcache.KindStats{
+			pkgcache.KindIssues: {Hits: 3, Misses: 1},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printCacheStats(buf, entries, stats, now)
+
+	expected := `Entries: 3 (3.0KiB)
+
+Entries by kind:
+  facts    1  2.0KiB
+  issues   1  1.0KiB
+  unknown  1  10B
+
+Entries by linter:
+  errcheck  1  1.0KiB
+  govet     2  3.0KiB
+
+Entries by last use:
+  < 1h    1
+  < 1d    0
+  < 7d    1
+  < 30d   0
+  >= 30d  1
+
+Last run (2024-01-10T12:00:00Z):
+  issues  3 hits  1 misses  75.0% hit ratio
+`
+
+	assert.Equal(t, expected, buf.String())
+}
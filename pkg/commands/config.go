@@ -18,8 +18,13 @@ type configCommand struct {
 	viper *viper.Viper
 	cmd   *cobra.Command
 
-	opts       config.LoaderOptions
-	verifyOpts verifyOptions
+	opts        config.LoaderOptions
+	verifyOpts  verifyOptions
+	printOpts   printOptions
+	explainOpts explainOptions
+	migrateOpts migrateOptions
+
+	cfg *config.Config
 
 	buildInfo BuildInfo
 
@@ -53,6 +58,37 @@ func newConfigCommand(log logutils.Log, info BuildInfo) *configCommand {
 		SilenceErrors:     true,
 	}
 
+	printCommand := &cobra.Command{
+		Use:               "print",
+		Short:             "Print the configuration file",
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE:              c.executePrint,
+		SilenceUsage:      true,
+	}
+
+	explainCommand := &cobra.Command{
+		Use:   "explain linter",
+		Short: "Explain the settings of a linter",
+		Long: "Explain the settings of a linter (linters-settings) with their types, default values, and descriptions " +
+			"from the JSON schema embedded in the binary.",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE:              c.executeExplain,
+		SilenceUsage:      true,
+	}
+
+	migrateCommand := &cobra.Command{
+		Use:   "migrate",
+		Short: "Replace the deprecated options of the configuration file",
+		Long: "Replace the deprecated options of the configuration file by their replacements, in place.\n" +
+			"Only YAML configuration files are supported: the comments are preserved, but the file is reformatted.",
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE:              c.executeMigrate,
+		SilenceUsage:      true,
+	}
+
 	configCmd.AddCommand(
 		&cobra.Command{
 			Use:               "path",
@@ -62,6 +98,9 @@ func newConfigCommand(log logutils.Log, info BuildInfo) *configCommand {
 			Run:               c.executePath,
 		},
 		verifyCommand,
+		printCommand,
+		explainCommand,
+		migrateCommand,
 	)
 
 	flagSet := configCmd.PersistentFlags()
@@ -74,19 +113,46 @@ func newConfigCommand(log logutils.Log, info BuildInfo) *configCommand {
 	verifyFlagSet.StringVar(&c.verifyOpts.schemaURL, "schema", "", color.GreenString("JSON schema URL"))
 	_ = verifyFlagSet.MarkHidden("schema")
 
+	printFlagSet := printCommand.Flags()
+	printFlagSet.SortFlags = false // sort them as they are defined here
+
+	printFlagSet.BoolVar(&c.printOpts.resolved, "resolved", false,
+		color.GreenString("Print the configuration with the extended configuration files merged (extends)"))
+	printFlagSet.BoolVar(&c.printOpts.effective, "effective", false,
+		color.GreenString("Print the effective configuration: the configuration files, the flags, and the default values, "+
+			"with the deprecated options replaced"))
+
+	// The flags of the run command change the effective configuration.
+	setupLintersFlagSet(c.viper, printFlagSet)
+	setupRunFlagSet(c.viper, printFlagSet)
+	setupOutputFlagSet(c.viper, printFlagSet)
+	setupIssuesFlagSet(c.viper, printFlagSet)
+
+	explainFlagSet := explainCommand.Flags()
+	explainFlagSet.StringVar(&c.explainOpts.schemaURL, "schema", "", color.GreenString("JSON schema URL"))
+	_ = explainFlagSet.MarkHidden("schema")
+
+	migrateCommand.Flags().BoolVar(&c.migrateOpts.dryRun, "dry-run", false,
+		color.GreenString("Print the migrated configuration instead of writing the configuration file"))
+
 	c.cmd = configCmd
 
 	return c
 }
 
 func (c *configCommand) preRunE(cmd *cobra.Command, args []string) error {
-	// The command doesn't depend on the real configuration.
-	// It only needs to know the path of the configuration file.
-	cfg := config.NewDefault()
+	// The commands don't depend on the real configuration (except the effective configuration).
+	// They only need to know the path of the configuration file.
+	c.cfg = config.NewDefault()
 
-	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts, cfg, args)
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts, c.cfg, args)
+
+	opts := config.LoadOptions{}
+	if c.printOpts.effective {
+		opts = config.LoadOptions{CheckDeprecation: true, Validation: true}
+	}
 
-	err := loader.Load(config.LoadOptions{})
+	err := loader.Load(opts)
 	if err != nil {
 		return fmt.Errorf("can't load config: %w", err)
 	}
@@ -0,0 +1,222 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/golangci/golangci-lint/jsonschema"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+type explainOptions struct {
+	schemaURL string // For debugging purpose only (Flag only).
+}
+
+func (c *configCommand) executeExplain(_ *cobra.Command, args []string) error {
+	schema, err := loadExplainSchema(c.explainOpts.schemaURL)
+	if err != nil {
+		return fmt.Errorf("load JSON schema: %w", err)
+	}
+
+	return explainLinterSettings(logutils.StdOut, schema, args[0])
+}
+
+// loadExplainSchema reads the JSON schema of the `--schema` flag,
+// or the JSON schema embedded in the binary: the schema of the sources of the binary.
+func loadExplainSchema(schemaURL string) (map[string]any, error) {
+	content := jsonschema.Next
+
+	if schemaURL != "" {
+		var err error
+
+		content, err = readSchema(schemaURL)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var schema map[string]any
+
+	err := json.Unmarshal(content, &schema)
+	if err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+// explainLinterSettings prints the settings of a linter (`linters-settings.<linter>`) described by the JSON schema.
+func explainLinterSettings(w io.Writer, schema map[string]any, name string) error {
+	lintersSettings := schemaProperties(schema, schemaMap(schemaProperties(schema, schema)["linters-settings"]))
+
+	settings, ok := lintersSettings[name]
+	if !ok {
+		return fmt.Errorf("no settings for the linter %q", name)
+	}
+
+	node := resolveSchemaRef(schema, schemaMap(settings))
+
+	_, _ = fmt.Fprintf(w, "Settings of the linter %s (linters-settings.%s):\n", name, name)
+
+	if description, ok := node["description"].(string); ok {
+		_, _ = fmt.Fprintln(w, indentLines(description, "  "))
+	}
+
+	properties := schemaProperties(schema, node)
+	if len(properties) == 0 {
+		return fmt.Errorf("no documented settings for the linter %q", name)
+	}
+
+	explainProperties(w, schema, properties, "")
+
+	return nil
+}
+
+// explainProperties prints the properties of an object, the properties of the nested objects are prefixed by their parent.
+func explainProperties(w io.Writer, schema, properties map[string]any, prefix string) {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		property := resolveSchemaRef(schema, schemaMap(properties[name]))
+
+		header := prefix + name + " (" + schemaType(schema, property)
+		if value, ok := property["default"]; ok {
+			header += ", default: " + formatSchemaValue(value)
+		}
+
+		_, _ = fmt.Fprintf(w, "\n%s)\n", header)
+
+		if description, ok := property["description"].(string); ok {
+			_, _ = fmt.Fprintln(w, indentLines(description, "    "))
+		}
+
+		if values := schemaEnum(schema, property); len(values) != 0 {
+			_, _ = fmt.Fprintln(w, indentLines("Values: "+values, "    "))
+		}
+
+		if examples, ok := property["examples"].([]any); ok && len(examples) != 0 {
+			_, _ = fmt.Fprintln(w, indentLines("Examples: "+formatSchemaValues(examples), "    "))
+		}
+
+		if nested := schemaProperties(schema, property); len(nested) != 0 {
+			explainProperties(w, schema, nested, prefix+name+".")
+		}
+	}
+}
+
+// schemaType returns the type of a property: e.g. `string`, `array of string`, `boolean or string`.
+func schemaType(schema, property map[string]any) string {
+	var types []string
+
+	switch t := property["type"].(type) {
+	case string:
+		types = append(types, t)
+	case []any:
+		for _, item := range t {
+			types = append(types, fmt.Sprint(item))
+		}
+	}
+
+	for _, key := range []string{"oneOf", "anyOf"} {
+		alternatives, ok := property[key].([]any)
+		if !ok {
+			continue
+		}
+
+		for _, alternative := range alternatives {
+			if t := schemaType(schema, resolveSchemaRef(schema, schemaMap(alternative))); !slices.Contains(types, t) {
+				types = append(types, t)
+			}
+		}
+	}
+
+	if len(types) == 0 {
+		if _, ok := property["enum"]; ok {
+			return "string"
+		}
+
+		return "any"
+	}
+
+	for i, t := range types {
+		if t != "array" {
+			continue
+		}
+
+		if items, ok := property["items"]; ok {
+			types[i] = "array of " + schemaType(schema, resolveSchemaRef(schema, schemaMap(items)))
+		}
+	}
+
+	return strings.Join(types, " or ")
+}
+
+// schemaEnum returns the allowed values of a property, or of the items of an array.
+func schemaEnum(schema, property map[string]any) string {
+	if values, ok := property["enum"].([]any); ok {
+		return formatSchemaValues(values)
+	}
+
+	if items, ok := property["items"]; ok {
+		return schemaEnum(schema, resolveSchemaRef(schema, schemaMap(items)))
+	}
+
+	return ""
+}
+
+func schemaProperties(schema, node map[string]any) map[string]any {
+	return schemaMap(resolveSchemaRef(schema, node)["properties"])
+}
+
+// resolveSchemaRef returns the definition referenced by a node (`$ref`), or the node.
+// Only the references inside the schema are supported (e.g. `#/definitions/linters`).
+func resolveSchemaRef(schema, node map[string]any) map[string]any {
+	ref, ok := node["$ref"].(string)
+	if !ok || !strings.HasPrefix(ref, "#/") {
+		return node
+	}
+
+	resolved := schema
+	for _, key := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		resolved = schemaMap(resolved[key])
+	}
+
+	return resolved
+}
+
+func schemaMap(value any) map[string]any {
+	m, _ := value.(map[string]any)
+	return m
+}
+
+func formatSchemaValues(values []any) string {
+	formatted := make([]string, len(values))
+	for i, value := range values {
+		formatted[i] = formatSchemaValue(value)
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+func formatSchemaValue(value any) string {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(b)
+}
+
+func indentLines(text, indent string) string {
+	return indent + strings.ReplaceAll(strings.TrimSpace(text), "\n", "\n"+indent)
+}
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchema = `{
+  "definitions": {
+    "checks": {"enum": ["a", "b"]}
+  },
+  "properties": {
+    "linters-settings": {
+      "properties": {
+        "foo": {
+          "type": "object",
+          "properties": {
+            "max": {"description": "The maximum.", "type": "integer", "default": 10},
+            "checks": {"description": "The checks.\nAll by default.", "type": "array", "items": {"$ref": "#/definitions/checks"}},
+            "mode": {"anyOf": [{"type": "boolean"}, {"type": "string"}], "examples": ["strict"]},
+            "nested": {"type": "object", "properties": {"enabled": {"type": "boolean"}}}
+          }
+        },
+        "bar": {"type": "object"}
+      }
+    }
+  }
+}`
+
+func Test_explainLinterSettings(t *testing.T) {
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal([]byte(testSchema), &schema))
+
+	var buf bytes.Buffer
+
+	err := explainLinterSettings(&buf, schema, "foo")
+	require.NoError(t, err)
+
+	expected := `Settings of the linter foo (linters-settings.foo):
+
+checks (array of string)
+    The checks.
+    All by default.
+    Values: "a", "b"
+
+max (integer, default: 10)
+    The maximum.
+
+mode (boolean or string)
+    Examples: "strict"
+
+nested (object)
+
+nested.enabled (boolean)
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func Test_explainLinterSettings_error(t *testing.T) {
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal([]byte(testSchema), &schema))
+
+	testCases := []string{"unknown", "bar"}
+
+	for _, name := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			err := explainLinterSettings(&buf, schema, name)
+			require.Error(t, err)
+		})
+	}
+}
+
+func Test_loadExplainSchema(t *testing.T) {
+	// The embedded schema.
+	schema, err := loadExplainSchema("")
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	err = explainLinterSettings(&buf, schema, "gocyclo")
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), "min-complexity (integer, default: 30)")
+
+	// A schema file.
+	path := filepath.Join(t.TempDir(), "schema.json")
+
+	err = os.WriteFile(path, []byte(testSchema), 0o600)
+	require.NoError(t, err)
+
+	schema, err = loadExplainSchema(path)
+	require.NoError(t, err)
+
+	buf.Reset()
+
+	err = explainLinterSettings(&buf, schema, "foo")
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), "max (integer, default: 10)")
+}
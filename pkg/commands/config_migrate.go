@@ -0,0 +1,462 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/exitcodes"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+type migrateOptions struct {
+	dryRun bool
+}
+
+// configMigration replaces a deprecated option (see config.Loader.handleDeprecation) by its replacement.
+type configMigration struct {
+	deprecated  string
+	replacement string
+
+	// convert converts the value of the deprecated option into the value of the replacement (optional).
+	// A nil value means that the deprecated option has no effect: the option is removed.
+	convert func(value *yaml.Node) (*yaml.Node, error)
+
+	// loaded is true if the value of the deprecated option is copied into the replacement when the configuration is loaded:
+	// the deprecated option is omitted from the effective configuration.
+	loaded bool
+
+	// sameOnly is true if the deprecated option is only removed when its value is the value of the replacement:
+	// the replacement is a global option (e.g. `run.go`), moving the value would change the other linters.
+	sameOnly bool
+}
+
+var (
+	// errReplacementDefined means that a deprecated option can't be migrated because its replacement is already defined.
+	errReplacementDefined = errors.New("the replacement is already defined")
+
+	// errReplacementGlobal means that a deprecated option can't be migrated because its replacement is a global option.
+	errReplacementGlobal = errors.New("the replacement is a global option")
+)
+
+var configMigrations = []configMigration{
+	// Deprecated since v1.57.0
+	{deprecated: "run.skip-files", replacement: "issues.exclude-files", loaded: true},
+	{deprecated: "run.skip-dirs", replacement: "issues.exclude-dirs", loaded: true},
+	{deprecated: "run.skip-dirs-use-default", replacement: "issues.exclude-dirs-use-default", loaded: true},
+	{deprecated: "run.show-stats", replacement: "output.show-stats", loaded: true},
+	{deprecated: "output.format", replacement: "output.formats", convert: convertOutputFormat, loaded: true},
+
+	// Deprecated since v1.59.0
+	{
+		deprecated: "issues.exclude-generated-strict", replacement: "issues.exclude-generated",
+		convert: convertEnabledTo("strict"), loaded: true,
+	},
+
+	// Deprecated since v1.58.0
+	{deprecated: "linters-settings.gomnd", replacement: "linters-settings.mnd", convert: convertGoMndSettings},
+	{
+		deprecated: "linters-settings.sloglint.context-only", replacement: "linters-settings.sloglint.context",
+		convert: convertEnabledTo("all"), loaded: true,
+	},
+
+	// Deprecated since v1.33.0
+	{deprecated: "linters-settings.godot.check-all", replacement: "linters-settings.godot.scope", convert: convertEnabledTo("all")},
+
+	// Deprecated since v1.47.0
+	{deprecated: "linters-settings.gofumpt.lang-version", replacement: "run.go", sameOnly: true},
+	{deprecated: "linters-settings.staticcheck.go", replacement: "run.go", sameOnly: true},
+	{deprecated: "linters-settings.gosimple.go", replacement: "run.go", sameOnly: true},
+	{deprecated: "linters-settings.stylecheck.go", replacement: "run.go", sameOnly: true},
+}
+
+// renamedLinters are the deprecated names of the linters (`linters.enable`, `linters.disable`) and their new names.
+var renamedLinters = map[string]string{
+	"gomnd": "mnd", // Deprecated since v1.58.0
+}
+
+func (c *configCommand) executeMigrate(cmd *cobra.Command, _ []string) error {
+	usedConfigFile := c.getUsedConfig()
+	if usedConfigFile == "" {
+		c.log.Warnf("No config file detected")
+		os.Exit(exitcodes.NoConfigFileDetected)
+	}
+
+	switch strings.ToLower(filepath.Ext(usedConfigFile)) {
+	case ".yml", ".yaml":
+	default:
+		return fmt.Errorf("only the YAML configuration files can be migrated: %s", usedConfigFile)
+	}
+
+	content, err := os.ReadFile(usedConfigFile)
+	if err != nil {
+		return fmt.Errorf("read config file: %w", err)
+	}
+
+	migrated, changes, err := migrateConfig(content)
+	if err != nil {
+		return fmt.Errorf("migrate config file %s: %w", usedConfigFile, err)
+	}
+
+	for _, change := range changes {
+		cmd.Println(change)
+	}
+
+	if c.migrateOpts.dryRun {
+		_, err = logutils.StdOut.Write(migrated)
+		return err
+	}
+
+	if bytes.Equal(migrated, content) {
+		if len(changes) == 0 {
+			cmd.Println("No deprecated options to migrate")
+		}
+
+		return nil
+	}
+
+	return os.WriteFile(usedConfigFile, migrated, 0o644)
+}
+
+// migrateConfig replaces the deprecated options of a YAML configuration file,
+// and returns the migrated content with the descriptions of the changes.
+// The comments are preserved, but the content is reformatted (the content is unchanged if nothing is migrated).
+// A deprecated option is kept when its replacement is already defined.
+func migrateConfig(content []byte) ([]byte, []string, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, nil, err
+	}
+
+	if len(doc.Content) == 0 {
+		return content, nil, nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, nil, errors.New("the configuration is not a mapping")
+	}
+
+	var changes []string
+
+	modified := false
+
+	for _, m := range configMigrations {
+		change, err := m.apply(root)
+
+		switch {
+		case errors.Is(err, errReplacementDefined):
+			changes = append(changes, fmt.Sprintf("`%s` not replaced: `%s` is already defined", m.deprecated, m.replacement))
+
+		case errors.Is(err, errReplacementGlobal):
+			changes = append(changes, fmt.Sprintf("`%s` not replaced: `%s` applies to all the linters", m.deprecated, m.replacement))
+
+		case err != nil:
+			return nil, nil, fmt.Errorf("`%s`: %w", m.deprecated, err)
+
+		case change != "":
+			changes = append(changes, change)
+			modified = true
+		}
+	}
+
+	if renamed := renameLinters(root); len(renamed) != 0 {
+		changes = append(changes, renamed...)
+		modified = true
+	}
+
+	if !modified {
+		return content, changes, nil
+	}
+
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, nil, err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	return buf.Bytes(), changes, nil
+}
+
+// apply migrates the deprecated option, and returns the description of the change (empty if the option is not defined).
+// Returns errReplacementDefined if the deprecated option and its replacement are both defined,
+// errReplacementGlobal if the deprecated option can only be removed (see configMigration.sameOnly).
+func (m *configMigration) apply(root *yaml.Node) (string, error) {
+	keys := strings.Split(m.deprecated, ".")
+
+	// The mappings containing the deprecated option, from the root.
+	parents := []*yaml.Node{root}
+
+	for _, key := range keys[:len(keys)-1] {
+		node := yamlMappingValue(parents[len(parents)-1], key)
+		if node == nil {
+			return "", nil
+		}
+
+		parents = append(parents, node)
+	}
+
+	parent := parents[len(parents)-1]
+
+	index := yamlMappingKeyIndex(parent, keys[len(keys)-1])
+	if index == -1 {
+		return "", nil
+	}
+
+	replacementKeys := strings.Split(m.replacement, ".")
+
+	existing := root
+	for _, key := range replacementKeys[:len(replacementKeys)-1] {
+		existing = yamlMappingValue(existing, key)
+	}
+
+	keyNode, value := parent.Content[index], parent.Content[index+1]
+
+	if current := yamlMappingValue(existing, replacementKeys[len(replacementKeys)-1]); current != nil {
+		if m.convert != nil || !sameScalar(current, value) {
+			return "", errReplacementDefined
+		}
+
+		removeYAMLOption(parents, keys, index)
+
+		return fmt.Sprintf("`%s` removed: same value as `%s`", m.deprecated, m.replacement), nil
+	}
+
+	if m.sameOnly {
+		return "", errReplacementGlobal
+	}
+
+	if m.convert != nil {
+		original := value
+
+		var err error
+
+		value, err = m.convert(value)
+		if err != nil {
+			return "", err
+		}
+
+		// The line comment of a block value is written after the key.
+		if value != nil && value.Kind != yaml.ScalarNode && keyNode.LineComment == "" {
+			keyNode.LineComment = original.LineComment
+		}
+	}
+
+	if value == nil {
+		removeYAMLOption(parents, keys, index)
+
+		return fmt.Sprintf("`%s` removed: the option has no effect", m.deprecated), nil
+	}
+
+	target := root
+
+	for _, key := range replacementKeys[:len(replacementKeys)-1] {
+		var err error
+
+		target, err = yamlMappingEnsure(target, key)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// The key node contains the comments of the option.
+	keyNode.Value = replacementKeys[len(replacementKeys)-1]
+
+	if target == parent {
+		// The option is renamed in place.
+		parent.Content[index+1] = value
+	} else {
+		removeYAMLOption(parents, keys, index)
+
+		target.Content = append(target.Content, keyNode, value)
+	}
+
+	return fmt.Sprintf("`%s` replaced by `%s`", m.deprecated, m.replacement), nil
+}
+
+// removeYAMLOption removes the option at the index of the last parent, then the parents without options.
+// The head comment of a removed parent (e.g. the comment at the top of the file) is moved to the next option.
+func removeYAMLOption(parents []*yaml.Node, keys []string, index int) {
+	last := parents[len(parents)-1]
+	last.Content = append(last.Content[:index], last.Content[index+2:]...)
+
+	for i := len(parents) - 1; i > 0; i-- {
+		if len(parents[i].Content) != 0 {
+			return
+		}
+
+		mapping := parents[i-1]
+
+		index := yamlMappingKeyIndex(mapping, keys[i-1])
+		if index == -1 {
+			return
+		}
+
+		if comment := mapping.Content[index].HeadComment; comment != "" && index+2 < len(mapping.Content) {
+			next := mapping.Content[index+2]
+			next.HeadComment = strings.TrimSpace(comment + "\n\n" + next.HeadComment)
+		}
+
+		mapping.Content = append(mapping.Content[:index], mapping.Content[index+2:]...)
+	}
+}
+
+// renameLinters replaces the deprecated names of the linters (`linters.enable`, `linters.disable`).
+func renameLinters(root *yaml.Node) []string {
+	var changes []string
+
+	for _, key := range []string{"enable", "disable"} {
+		list := yamlMappingValue(yamlMappingValue(root, "linters"), key)
+		if list == nil || list.Kind != yaml.SequenceNode {
+			continue
+		}
+
+		for _, item := range list.Content {
+			name, ok := renamedLinters[item.Value]
+			if !ok || item.Kind != yaml.ScalarNode {
+				continue
+			}
+
+			changes = append(changes, fmt.Sprintf("linter `%s` renamed to `%s` in `linters.%s`", item.Value, name, key))
+
+			item.Value = name
+		}
+	}
+
+	return changes
+}
+
+// convertEnabledTo converts a boolean option into a string option:
+// `true` is replaced by the value, `false` has no effect.
+func convertEnabledTo(replacement string) func(value *yaml.Node) (*yaml.Node, error) {
+	return func(value *yaml.Node) (*yaml.Node, error) {
+		var enabled bool
+		if err := value.Decode(&enabled); err != nil {
+			return nil, err
+		}
+
+		if !enabled {
+			return nil, nil
+		}
+
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: replacement, LineComment: value.LineComment}, nil
+	}
+}
+
+// convertOutputFormat converts the value of `output.format` (e.g. `json:report.json,colored-line-number`)
+// into the list of `output.formats`.
+func convertOutputFormat(value *yaml.Node) (*yaml.Node, error) {
+	if value.Kind != yaml.ScalarNode {
+		return nil, errors.New("the value is not a string")
+	}
+
+	var formats config.OutputFormats
+	if err := formats.UnmarshalText([]byte(value.Value)); err != nil {
+		return nil, err
+	}
+
+	list := &yaml.Node{Kind: yaml.SequenceNode}
+
+	for _, format := range formats {
+		item := &yaml.Node{Kind: yaml.MappingNode}
+		item.Content = append(item.Content, yamlScalar("format"), yamlScalar(format.Format))
+
+		if format.Path != "" {
+			item.Content = append(item.Content, yamlScalar("path"), yamlScalar(format.Path))
+		}
+
+		list.Content = append(list.Content, item)
+	}
+
+	return list, nil
+}
+
+// convertGoMndSettings moves the deprecated `settings.mnd` options of the gomnd settings to the root of the settings.
+func convertGoMndSettings(value *yaml.Node) (*yaml.Node, error) {
+	if value.Kind != yaml.MappingNode {
+		return value, nil
+	}
+
+	index := yamlMappingKeyIndex(value, "settings")
+	if index == -1 {
+		return value, nil
+	}
+
+	settings := yamlMappingValue(value.Content[index+1], "mnd")
+
+	value.Content = append(value.Content[:index], value.Content[index+2:]...)
+
+	if settings == nil || settings.Kind != yaml.MappingNode {
+		return value, nil
+	}
+
+	// Like the linter, the deprecated settings take precedence.
+	for i := 0; i+1 < len(settings.Content); i += 2 {
+		if current := yamlMappingKeyIndex(value, settings.Content[i].Value); current != -1 {
+			value.Content[current+1] = settings.Content[i+1]
+			continue
+		}
+
+		value.Content = append(value.Content, settings.Content[i], settings.Content[i+1])
+	}
+
+	return value, nil
+}
+
+// yamlMappingKeyIndex returns the index of the key node, -1 if the node is not a mapping or the key doesn't exist.
+func yamlMappingKeyIndex(node *yaml.Node, key string) int {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return -1
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// yamlMappingEnsure returns the mapping value of the key, the value is created if the key doesn't exist.
+func yamlMappingEnsure(node *yaml.Node, key string) (*yaml.Node, error) {
+	value := yamlMappingValue(node, key)
+
+	switch {
+	case value == nil:
+		value = &yaml.Node{Kind: yaml.MappingNode}
+		node.Content = append(node.Content, yamlScalar(key), value)
+
+	case value.Kind == yaml.ScalarNode && value.Tag == "!!null":
+		// An empty value (e.g. `issues:`).
+		*value = yaml.Node{Kind: yaml.MappingNode}
+
+	case value.Kind != yaml.MappingNode:
+		return nil, fmt.Errorf("unexpected type of %q", key)
+	}
+
+	return value, nil
+}
+
+// sameScalar returns true if the nodes are scalars with the same value.
+func sameScalar(a, b *yaml.Node) bool {
+	return a.Kind == yaml.ScalarNode && b.Kind == yaml.ScalarNode && a.Value == b.Value
+}
+
+func yamlScalar(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Value: value}
+}
@@ -0,0 +1,158 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_migrateConfig(t *testing.T) {
+	testCases := []struct {
+		desc            string
+		content         string
+		expected        string
+		expectedChanges []string
+	}{
+		{
+			desc: "moved options",
+			content: `# Configuration.
+run:
+  # Skipped directories.
+  skip-dirs:
+    - legacy # Old code.
+  show-stats: true
+issues:
+  max-same-issues: 0
+`,
+			expected: `# Configuration.
+issues:
+  max-same-issues: 0
+  # Skipped directories.
+  exclude-dirs:
+    - legacy # Old code.
+output:
+  show-stats: true
+`,
+			expectedChanges: []string{
+				"`run.skip-dirs` replaced by `issues.exclude-dirs`",
+				"`run.show-stats` replaced by `output.show-stats`",
+			},
+		},
+		{
+			desc: "converted options",
+			content: `output:
+  format: json:report.json,colored-line-number
+  sort-results: true
+linters-settings:
+  godot:
+    check-all: true
+  sloglint:
+    context-only: false
+`,
+			expected: `output:
+  formats:
+    - format: json
+      path: report.json
+    - format: colored-line-number
+  sort-results: true
+linters-settings:
+  godot:
+    scope: all
+`,
+			expectedChanges: []string{
+				"`output.format` replaced by `output.formats`",
+				"`linters-settings.sloglint.context-only` removed: the option has no effect",
+				"`linters-settings.godot.check-all` replaced by `linters-settings.godot.scope`",
+			},
+		},
+		{
+			desc: "renamed linter",
+			content: `linters:
+  enable:
+    - gomnd
+linters-settings:
+  gomnd:
+    ignored-numbers:
+      - "0666"
+    settings:
+      mnd:
+        checks: argument,case
+`,
+			expected: `linters:
+  enable:
+    - mnd
+linters-settings:
+  mnd:
+    ignored-numbers:
+      - "0666"
+    checks: argument,case
+`,
+			expectedChanges: []string{
+				"`linters-settings.gomnd` replaced by `linters-settings.mnd`",
+				"linter `gomnd` renamed to `mnd` in `linters.enable`",
+			},
+		},
+		{
+			desc: "replacement already defined",
+			content: `run:
+  go: "1.22"
+linters-settings:
+  gosimple:
+    go: "1.22"
+  stylecheck:
+    go: "1.20"
+`,
+			expected: `run:
+  go: "1.22"
+linters-settings:
+  stylecheck:
+    go: "1.20"
+`,
+			expectedChanges: []string{
+				"`linters-settings.gosimple.go` removed: same value as `run.go`",
+				"`linters-settings.stylecheck.go` not replaced: `run.go` is already defined",
+			},
+		},
+		{
+			desc: "global replacement",
+			content: `linters-settings:
+  staticcheck:
+    go: "1.20"
+`,
+			expected: `linters-settings:
+  staticcheck:
+    go: "1.20"
+`,
+			expectedChanges: []string{
+				"`linters-settings.staticcheck.go` not replaced: `run.go` applies to all the linters",
+			},
+		},
+		{
+			desc: "no deprecated options",
+			content: `run:
+    timeout: 5m
+`,
+			expected: `run:
+    timeout: 5m
+`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			migrated, changes, err := migrateConfig([]byte(test.content))
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, string(migrated))
+			assert.Equal(t, test.expectedChanges, changes)
+		})
+	}
+}
+
+func Test_migrateConfig_error(t *testing.T) {
+	_, _, err := migrateConfig([]byte("run:\n  skip-dirs: [a]\nissues: [b]\n"))
+	require.Error(t, err)
+}
@@ -0,0 +1,173 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/exitcodes"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+type printOptions struct {
+	resolved  bool
+	effective bool
+}
+
+func (c *configCommand) executePrint(_ *cobra.Command, _ []string) error {
+	if c.printOpts.effective {
+		settings, _ := toSettings(reflect.ValueOf(c.cfg)).(map[string]any)
+
+		// The values of these deprecated options are already in their replacements.
+		for _, m := range configMigrations {
+			if m.loaded {
+				deleteSetting(settings, strings.Split(m.deprecated, "."))
+			}
+		}
+
+		// The internal options are only used by the tests.
+		delete(settings, "internalcmdtest")
+		delete(settings, "internaltest")
+
+		return printSettings(settings)
+	}
+
+	usedConfigFile := c.viper.ConfigFileUsed()
+	if usedConfigFile == "" {
+		c.log.Warnf("No config file detected")
+		os.Exit(exitcodes.NoConfigFileDetected)
+	}
+
+	if !c.printOpts.resolved {
+		content, err := os.ReadFile(usedConfigFile)
+		if err != nil {
+			return fmt.Errorf("read config file: %w", err)
+		}
+
+		_, err = logutils.StdOut.Write(content)
+
+		return err
+	}
+
+	settings, err := config.LoadResolved(usedConfigFile)
+	if err != nil {
+		return fmt.Errorf("resolve config file: %w", err)
+	}
+
+	return printSettings(settings)
+}
+
+func printSettings(settings any) error {
+	encoder := yaml.NewEncoder(logutils.StdOut)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(settings); err != nil {
+		return fmt.Errorf("print config: %w", err)
+	}
+
+	return encoder.Close()
+}
+
+// deleteSetting deletes an option from the settings.
+func deleteSetting(settings map[string]any, keys []string) {
+	for _, key := range keys[:len(keys)-1] {
+		var ok bool
+
+		settings, ok = settings[key].(map[string]any)
+		if !ok {
+			return
+		}
+	}
+
+	delete(settings, keys[len(keys)-1])
+}
+
+// toSettings converts a value of the configuration into settings using the keys of the configuration file (mapstructure tags).
+// All the fields are included: the fields with a zero value are a part of the effective configuration (e.g. `run.tests: false`).
+func toSettings(value reflect.Value) any {
+	switch value.Kind() {
+	case reflect.Invalid:
+		return nil
+
+	case reflect.Pointer, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+
+		return toSettings(value.Elem())
+
+	case reflect.Struct:
+		settings := map[string]any{}
+
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			name, tagOptions, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+			if name == "-" {
+				continue
+			}
+
+			fieldSettings := toSettings(value.Field(i))
+
+			if tagOptions == "squash" {
+				if m, ok := fieldSettings.(map[string]any); ok {
+					for k, v := range m {
+						settings[k] = v
+					}
+				}
+
+				continue
+			}
+
+			if name == "" {
+				name = strings.ToLower(field.Name)
+			}
+
+			settings[name] = fieldSettings
+		}
+
+		return settings
+
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return nil
+		}
+
+		list := make([]any, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			list[i] = toSettings(value.Index(i))
+		}
+
+		return list
+
+	case reflect.Map:
+		if value.IsNil() {
+			return nil
+		}
+
+		settings := map[string]any{}
+
+		iter := value.MapRange()
+		for iter.Next() {
+			settings[fmt.Sprint(iter.Key().Interface())] = toSettings(iter.Value())
+		}
+
+		return settings
+
+	default:
+		if d, ok := value.Interface().(time.Duration); ok {
+			return d.String()
+		}
+
+		return value.Interface()
+	}
+}
@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+)
+
+func Test_toSettings(t *testing.T) {
+	type Base struct {
+		Path string `mapstructure:"path"`
+	}
+
+	type rule struct {
+		Base    `mapstructure:",squash"`
+		Linters []string
+		Skip    string `mapstructure:"-"`
+	}
+
+	type settings struct {
+		Timeout  time.Duration     `mapstructure:"timeout"`
+		Tests    bool              `mapstructure:"tests"`
+		MaxSame  int               `mapstructure:"max-same"`
+		Rules    []rule            `mapstructure:"rules"`
+		Empty    []string          `mapstructure:"empty"`
+		PerCheck map[string]any    `mapstructure:"per-check"`
+		Nested   *settings         `mapstructure:"nested"`
+		Tags     map[string]string `mapstructure:"tags"`
+	}
+
+	value := &settings{
+		Timeout:  time.Minute,
+		Rules:    []rule{{Base: Base{Path: "_test.go"}, Linters: []string{"errcheck"}, Skip: "skip"}},
+		PerCheck: map[string]any{"foo": map[string]any{"bar": 1}},
+	}
+
+	expected := map[string]any{
+		"timeout":  "1m0s",
+		"tests":    false,
+		"max-same": 0,
+		"rules": []any{
+			map[string]any{"path": "_test.go", "linters": []any{"errcheck"}},
+		},
+		"empty":     nil,
+		"per-check": map[string]any{"foo": map[string]any{"bar": 1}},
+		"nested":    nil,
+		"tags":      nil,
+	}
+
+	assert.Equal(t, expected, toSettings(reflect.ValueOf(value)))
+}
+
+func Test_toSettings_config(t *testing.T) {
+	settings, ok := toSettings(reflect.ValueOf(&config.Config{})).(map[string]any)
+	require.True(t, ok)
+
+	// The zero values are a part of the effective configuration.
+	assert.Equal(t, false, settings["run"].(map[string]any)["tests"])
+	assert.Equal(t, 0, settings["issues"].(map[string]any)["max-same-issues"])
+}
@@ -3,6 +3,7 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -100,7 +101,7 @@ func createSchemaURL(flags *pflag.FlagSet, buildInfo BuildInfo) (string, error)
 }
 
 func validateConfiguration(schemaPath, targetFile string) error {
-	httploader.Client = &http.Client{Timeout: 2 * time.Second}
+	setupSchemaLoaders()
 
 	compiler := jsonschema.NewCompiler()
 	compiler.Draft = jsonschema.Draft7
@@ -133,6 +134,28 @@ func validateConfiguration(schemaPath, targetFile string) error {
 	return schema.Validate(m)
 }
 
+func setupSchemaLoaders() {
+	httploader.Client = &http.Client{Timeout: 2 * time.Second}
+}
+
+// readSchema reads a JSON schema from a URL (with the loaders of the validation), or from a file path.
+func readSchema(schemaURL string) ([]byte, error) {
+	if !strings.Contains(schemaURL, "://") {
+		return os.ReadFile(schemaURL)
+	}
+
+	setupSchemaLoaders()
+
+	r, err := jsonschema.LoadURL(schemaURL)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = r.Close() }()
+
+	return io.ReadAll(r)
+}
+
 func printValidationDetail(cmd *cobra.Command, detail *jsonschema.Detailed) {
 	if detail.Error != "" {
 		cmd.PrintErrf("jsonschema: %q does not validate with %q: %s\n",
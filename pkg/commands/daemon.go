@@ -0,0 +1,114 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/daemon"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type daemonCommand struct {
+	viper *viper.Viper
+	cmd   *cobra.Command
+
+	opts config.LoaderOptions
+
+	cfg *config.Config
+
+	buildInfo BuildInfo
+
+	session *lintSession
+
+	log logutils.Log
+}
+
+func newDaemonCommand(logger logutils.Log, info BuildInfo) *daemonCommand {
+	c := &daemonCommand{
+		viper:     viper.New(),
+		cfg:       config.NewDefault(),
+		buildInfo: info,
+		log:       logger,
+	}
+
+	daemonCmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "Run the linters daemon",
+		Long: "Run a daemon serving `golangci-lint run --daemon` in the current directory.\n" +
+			"The configuration, the packages, the facts and the issues are kept in memory: " +
+			"only the changed packages and their dependents are analyzed again.\n" +
+			"The daemon stops when the configuration file is changed.",
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		PreRunE:           c.preRunE,
+		RunE:              c.execute,
+		SilenceUsage:      true,
+	}
+
+	daemonCmd.SetOut(logutils.StdOut) // use custom output to properly color it in Windows terminals
+
+	fs := daemonCmd.Flags()
+	fs.SortFlags = false // sort them as they are defined here
+
+	setupConfigFileFlagSet(fs, &c.opts)
+
+	setupLintersFlagSet(c.viper, fs)
+	setupRunFlagSet(c.viper, fs)
+	setupIssuesFlagSet(c.viper, fs)
+
+	setupLintSessionDefaults(c.viper)
+
+	c.cmd = daemonCmd
+
+	return c
+}
+
+func (c *daemonCommand) preRunE(cmd *cobra.Command, args []string) error {
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts, c.cfg, args)
+
+	err := loader.Load(config.LoadOptions{CheckDeprecation: true, Validation: true})
+	if err != nil {
+		return fmt.Errorf("can't load config: %w", err)
+	}
+
+	c.session, err = newLintSession(c.log, c.cfg, c.buildInfo)
+
+	return err
+}
+
+func (c *daemonCommand) execute(_ *cobra.Command, _ []string) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	wd, err := fsutils.Getwd()
+	if err != nil {
+		return fmt.Errorf("can't get working dir: %w", err)
+	}
+
+	c.session.discoverGoEnv(ctx)
+
+	watcher, err := daemon.NewWatcher(c.log.Child(logutils.DebugKeyDaemon), wd, c.viper.ConfigFileUsed(), c.session.changed)
+	if err != nil {
+		return fmt.Errorf("failed to watch the files: %w", err)
+	}
+
+	socket := daemon.SocketPath(wd)
+
+	server := daemon.NewServer(c.log.Child(logutils.DebugKeyDaemon), socket, wd, watcher,
+		func(ctx context.Context, args []string) ([]result.Issue, error) {
+			return c.session.lint(ctx, args)
+		})
+
+	c.cmd.Printf("Daemon listening on %s\n", socket)
+
+	return server.Run(ctx)
+}
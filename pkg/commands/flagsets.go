@@ -65,11 +65,14 @@ func setupRunFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 	const allowSerialDesc = "Allow multiple golangci-lint instances running, but serialize them around a lock.\n" +
 		"If false (default) - golangci-lint exits with an error if it fails to acquire file lock on start."
 	internal.AddFlagAndBind(v, fs, fs.Bool, "allow-serial-runners", "run.allow-serial-runners", false, color.GreenString(allowSerialDesc))
+
+	internal.AddFlagAndBind(v, fs, fs.Bool, "nested-configs", "run.nested-configs", false,
+		color.GreenString("Apply the configuration files found in the subdirectories to the files of these subdirectories"))
 }
 
 func setupOutputFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 	internal.AddFlagAndBind(v, fs, fs.String, "out-format", "output.formats", config.OutFormatColoredLineNumber,
-		color.GreenString(fmt.Sprintf("Formats of output: %s", strings.Join(config.AllOutputFormats, "|"))))
+		color.GreenString(fmt.Sprintf("Formats of output: %s|%s:PATH", strings.Join(config.AllOutputFormats, "|"), config.OutFormatTemplate)))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "print-issued-lines", "output.print-issued-lines", true,
 		color.GreenString("Print lines of code with issue"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "print-linter-name", "output.print-linter-name", true,
@@ -83,6 +86,12 @@ func setupOutputFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 	internal.AddFlagAndBind(v, fs, fs.String, "path-prefix", "output.path-prefix", "",
 		color.GreenString("Path prefix to add to output"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "show-stats", "output.show-stats", false, color.GreenString("Show statistics per linter"))
+	internal.AddFlagAndBind(v, fs, fs.Bool, "stream", "output.stream", false,
+		color.GreenString("Print the issues as soon as they are found (the issues are not sorted)"))
+	internal.AddFlagAndBind(v, fs, fs.String, "markdown-permalink-base-url", "output.markdown.permalink-base-url", "",
+		color.GreenString("Base URL of the permalinks of the markdown output (ex: https://github.com/org/repo/blob)"))
+	internal.AddFlagAndBind(v, fs, fs.String, "markdown-revision", "output.markdown.revision", "",
+		color.GreenString("Revision of the permalinks of the markdown output"))
 }
 
 //nolint:gomnd // magic numbers here is ok
@@ -119,8 +128,24 @@ func setupIssuesFlagSet(v *viper.Viper, fs *pflag.FlagSet) {
 		color.GreenString("Show only new issues created in git patch with file path `PATH`"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "whole-files", "issues.whole-files", false,
 		color.GreenString("Show issues in any part of update files (requires new-from-rev or new-from-patch)"))
+	internal.AddFlagAndBind(v, fs, fs.String, "baseline", "issues.baseline", "",
+		color.GreenString("Hide issues recorded in the baseline file `PATH` (created by 'golangci-lint baseline create')"))
+	internal.AddFlagAndBind(v, fs, fs.Bool, "ratchet-budgets", "issues.ratchet-budgets", false,
+		color.GreenString("Lower the budgets of the configuration files to the current numbers of issues"))
+	internal.AddFlagAndBind(v, fs, fs.String, "fail-on-severity", "issues.fail-on-severity", "",
+		color.GreenString("Only the issues with this severity or a higher severity fail the run (hint, info, warning, error)"))
+	internal.AddFlagAndBind(v, fs, fs.Bool, "fail-on-new-only", "issues.fail-on-new-only", false,
+		color.GreenString("Report the issues of the baseline and outside the diff, but only the new issues fail the run"))
+	internal.AddFlagAndBind(v, fs, fs.Bool, "severity-exit-codes", "issues.severity-exit-codes", false,
+		color.GreenString("Use the exit code of the most severe issue (10: hint, 11: info, 12: warning, 13: error)"))
+	internal.AddFlagAndBind(v, fs, fs.Bool, "fail-on-expired-suppressions", "issues.fail-on-expired-suppressions", false,
+		color.GreenString("Fail the run if an exclude rule or a nolint directive has expired"))
+	internal.AddFlagAndBind(v, fs, fs.Bool, "report-unused-exclusions", "issues.report-unused-exclusions", false,
+		color.GreenString("Report the exclude patterns and the exclude rules without matched issues"))
 	internal.AddFlagAndBind(v, fs, fs.Bool, "fix", "issues.fix", false,
 		color.GreenString("Fix found issues (if it's supported by the linter)"))
+	internal.AddFlagAndBind(v, fs, fs.String, "fix-output", "issues.fix-output", "",
+		color.GreenString("Don't modify files: write a unified diff of the fixes instead (diff or diff:PATH, requires fix)"))
 }
 
 func getDefaultIssueExcludeHelp() string {
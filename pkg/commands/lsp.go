@@ -0,0 +1,116 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/daemon"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/lsp"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+type lspCommand struct {
+	viper *viper.Viper
+	cmd   *cobra.Command
+
+	opts config.LoaderOptions
+
+	cfg *config.Config
+
+	buildInfo BuildInfo
+
+	session *lintSession
+
+	log logutils.Log
+}
+
+func newLspCommand(logger logutils.Log, info BuildInfo) *lspCommand {
+	c := &lspCommand{
+		viper:     viper.New(),
+		cfg:       config.NewDefault(),
+		buildInfo: info,
+		log:       logger,
+	}
+
+	lspCmd := &cobra.Command{
+		Use:   "lsp",
+		Short: "Run the language server",
+		Long: "Run a Language Server Protocol server over stdio.\n" +
+			"The package of a file is linted when the file is opened or saved: " +
+			"the issues are published as diagnostics and their fixes as code actions.",
+		Args:              cobra.NoArgs,
+		ValidArgsFunction: cobra.NoFileCompletions,
+		PreRunE:           c.preRunE,
+		RunE:              c.execute,
+		SilenceUsage:      true,
+	}
+
+	fs := lspCmd.Flags()
+	fs.SortFlags = false // sort them as they are defined here
+
+	setupConfigFileFlagSet(fs, &c.opts)
+
+	setupLintersFlagSet(c.viper, fs)
+	setupRunFlagSet(c.viper, fs)
+	setupIssuesFlagSet(c.viper, fs)
+
+	setupLintSessionDefaults(c.viper)
+
+	c.cmd = lspCmd
+
+	return c
+}
+
+func (c *lspCommand) preRunE(cmd *cobra.Command, args []string) error {
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts, c.cfg, args)
+
+	err := loader.Load(config.LoadOptions{CheckDeprecation: true, Validation: true})
+	if err != nil {
+		return fmt.Errorf("can't load config: %w", err)
+	}
+
+	c.session, err = newLintSession(c.log, c.cfg, c.buildInfo)
+
+	return err
+}
+
+func (c *lspCommand) execute(_ *cobra.Command, _ []string) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wd, err := fsutils.Getwd()
+	if err != nil {
+		return fmt.Errorf("can't get working dir: %w", err)
+	}
+
+	c.session.discoverGoEnv(ctx)
+
+	// The changes of the files outside the editor (e.g. git checkout) are detected by the watcher.
+	watcher, err := daemon.NewWatcher(c.log.Child(logutils.DebugKeyLSP), wd, "", c.session.changed)
+	if err != nil {
+		return fmt.Errorf("failed to watch the files: %w", err)
+	}
+
+	go watcher.Run(ctx)
+
+	// The standard output is reserved for the protocol:
+	// don't allow the linters and the loader to write into it.
+	stdout := os.Stdout
+	os.Stdout = os.Stderr
+
+	defer func() { os.Stdout = stdout }()
+
+	server := lsp.NewServer(c.log.Child(logutils.DebugKeyLSP), os.Stdin, stdout, c.buildInfo.Version,
+		func(ctx context.Context, dir string) ([]result.Issue, error) {
+			return c.session.lint(ctx, []string{dir})
+		}, c.session.changed)
+
+	return server.Run(ctx)
+}
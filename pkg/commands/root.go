@@ -60,6 +60,11 @@ func newRootCommand(info BuildInfo) *rootCommand {
 	rootCmd.AddCommand(
 		newLintersCommand(log).cmd,
 		newRunCommand(log, info).cmd,
+		newBaselineCommand(log, info).cmd,
+		newTriageCommand(log, info).cmd,
+		newSuppressionsCommand(log).cmd,
+		newLspCommand(log, info).cmd,
+		newDaemonCommand(log, info).cmd,
 		newCacheCommand().cmd,
 		newConfigCommand(log, info).cmd,
 		newVersionCommand(info).cmd,
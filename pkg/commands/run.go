@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -13,6 +14,7 @@ import (
 	"runtime"
 	"runtime/pprof"
 	"runtime/trace"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -30,6 +32,7 @@ import (
 	"github.com/golangci/golangci-lint/internal/cache"
 	"github.com/golangci/golangci-lint/internal/pkgcache"
 	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/daemon"
 	"github.com/golangci/golangci-lint/pkg/exitcodes"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/goanalysis/load"
@@ -41,11 +44,21 @@ import (
 	"github.com/golangci/golangci-lint/pkg/printers"
 	"github.com/golangci/golangci-lint/pkg/report"
 	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/result/processors"
 	"github.com/golangci/golangci-lint/pkg/timeutils"
+	"github.com/golangci/golangci-lint/pkg/tracing"
 )
 
 const defaultTimeout = time.Minute
 
+// severityExitCodes are the exit codes of the known severities (`issues.severity-exit-codes`).
+var severityExitCodes = map[string]int{
+	config.SeverityHint:    exitcodes.HintIssuesFound,
+	config.SeverityInfo:    exitcodes.InfoIssuesFound,
+	config.SeverityWarning: exitcodes.WarningIssuesFound,
+	config.SeverityError:   exitcodes.ErrorIssuesFound,
+}
+
 const (
 	// envFailOnWarnings value: "1"
 	envFailOnWarnings = "FAIL_ON_WARNINGS"
@@ -66,7 +79,18 @@ type runOptions struct {
 	MemProfilePath string // Flag only.
 	TracePath      string // Flag only.
 
+	TraceChromePath   string // Flag only.
+	TraceOTLPEndpoint string // Flag only.
+
+	TimingReportPath string // Flag only.
+
 	PrintResourcesUsage bool // Flag only.
+
+	Daemon bool // Flag only.
+
+	CreateBaseline bool // Command only (`golangci-lint baseline create`).
+
+	Triage bool // Command only (`golangci-lint triage`).
 }
 
 type runCommand struct {
@@ -89,6 +113,14 @@ type runCommand struct {
 
 	contextBuilder *lint.ContextBuilder
 	goenv          *goutil.Env
+	pkgCache       *pkgcache.Cache
+
+	timingReport *timeutils.Report
+	tracer       *tracing.Tracer
+
+	linterRuns map[string]lint.LinterRun
+
+	budgets *processors.Budgets
 
 	fileCache *fsutils.FileCache
 	lineCache *fsutils.LineCache
@@ -156,11 +188,27 @@ func (c *runCommand) persistentPreRunE(cmd *cobra.Command, args []string) error
 
 	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts.LoaderOptions, c.cfg, args)
 
-	err := loader.Load(config.LoadOptions{CheckDeprecation: true, Validation: true})
+	var err error
+	tracing.Track(tracing.WithTracer(context.Background(), c.tracer), "config loading", func(_ context.Context) {
+		err = loader.Load(config.LoadOptions{CheckDeprecation: true, Validation: true})
+	})
 	if err != nil {
 		return fmt.Errorf("can't load config: %w", err)
 	}
 
+	if c.opts.CreateBaseline {
+		c.setupBaselineCreation()
+	}
+
+	if c.opts.Triage {
+		c.setupTriage()
+	}
+
+	// The fixes of the different linters are applied together on the same files.
+	if c.cfg.Output.Stream && c.cfg.Issues.NeedFix {
+		return errors.New("the issues can't be streamed and fixed at the same time: use either --stream or --fix")
+	}
+
 	if c.cfg.Run.Concurrency == 0 {
 		backup := runtime.GOMAXPROCS(0)
 
@@ -209,7 +257,7 @@ func (c *runCommand) preRunE(_ *cobra.Command, args []string) error {
 
 	sw := timeutils.NewStopwatch("pkgcache", c.log.Child(logutils.DebugKeyStopwatch))
 
-	pkgCache, err := pkgcache.NewCache(sw, c.log.Child(logutils.DebugKeyPkgCache))
+	c.pkgCache, err = pkgcache.NewCache(sw, c.log.Child(logutils.DebugKeyPkgCache))
 	if err != nil {
 		return fmt.Errorf("failed to build packages cache: %w", err)
 	}
@@ -218,7 +266,14 @@ func (c *runCommand) preRunE(_ *cobra.Command, args []string) error {
 
 	pkgLoader := lint.NewPackageLoader(c.log.Child(logutils.DebugKeyLoader), c.cfg, args, c.goenv, guard)
 
-	c.contextBuilder = lint.NewContextBuilder(c.cfg, pkgLoader, c.fileCache, pkgCache, guard)
+	c.contextBuilder = lint.NewContextBuilder(c.cfg, pkgLoader, c.fileCache, c.pkgCache, guard)
+
+	// The json-v2 format contains the durations of the linters.
+	if c.opts.TimingReportPath != "" || slices.ContainsFunc(c.cfg.Output.Formats, func(f config.OutputFormat) bool {
+		return f.Format == config.OutFormatJSONV2
+	}) {
+		c.timingReport = timeutils.NewReport()
+	}
 
 	if err = initHashSalt(c.buildInfo.Version, c.cfg); err != nil {
 		return fmt.Errorf("failed to init hash salt: %w", err)
@@ -252,7 +307,11 @@ func (c *runCommand) execute(_ *cobra.Command, args []string) {
 		go watchResources(ctx, trackResourcesEndCh, c.log, c.debugf)
 	}
 
-	if err := c.runAndPrint(ctx, args); err != nil {
+	runCtx, span := tracing.Start(tracing.WithTracer(ctx, c.tracer), "run")
+	err := c.runAndPrint(runCtx, args)
+	span.Finish()
+
+	if err != nil {
 		c.log.Errorf("Running error: %s", err)
 		if c.exitCode == exitcodes.Success {
 			var exitErr *exitcodes.ExitError
@@ -294,6 +353,10 @@ func (c *runCommand) startTracing() error {
 		}
 	}
 
+	if c.opts.TraceChromePath != "" || c.opts.TraceOTLPEndpoint != "" {
+		c.tracer = tracing.NewTracer()
+	}
+
 	return nil
 }
 
@@ -322,10 +385,37 @@ func (c *runCommand) stopTracing() error {
 		trace.Stop()
 	}
 
+	if c.opts.TraceChromePath != "" {
+		f, err := os.Create(c.opts.TraceChromePath)
+		if err != nil {
+			return fmt.Errorf("can't create file %s: %w", c.opts.TraceChromePath, err)
+		}
+
+		err = tracing.WriteChrome(f, c.tracer.Spans())
+		_ = f.Close()
+		if err != nil {
+			return fmt.Errorf("can't write trace events: %w", err)
+		}
+	}
+
+	if c.opts.TraceOTLPEndpoint != "" {
+		const exportTimeout = 10 * time.Second
+
+		ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+		defer cancel()
+
+		err := tracing.ExportOTLP(ctx, c.opts.TraceOTLPEndpoint, c.buildInfo.Version, c.tracer)
+		if err != nil {
+			return fmt.Errorf("can't export the spans to %s: %w", c.opts.TraceOTLPEndpoint, err)
+		}
+	}
+
 	return nil
 }
 
 func (c *runCommand) runAndPrint(ctx context.Context, args []string) error {
+	startedAt := time.Now()
+
 	if err := c.goenv.Discover(ctx); err != nil {
 		c.log.Warnf("Failed to discover go env: %s", err)
 	}
@@ -347,23 +437,52 @@ func (c *runCommand) runAndPrint(ctx context.Context, args []string) error {
 	c.printDeprecatedLinterMessages(enabledLintersMap)
 
 	issues, err := c.runAnalysis(ctx, args)
-	if err != nil {
+	// The report is printed when some linters failed: the errors of the linters are reported (json-v2, sarif),
+	// and the error sets the exit code.
+	if err != nil && (!c.hasFailedLinters() || c.opts.CreateBaseline || c.opts.Triage) {
 		return err // XXX: don't lose type
 	}
 
+	lintErr := err
+
+	if c.opts.CreateBaseline {
+		c.cmd.Printf("Baseline created: %s\n", c.cfg.Issues.Baseline)
+		return nil
+	}
+
+	if c.opts.Triage {
+		return c.triage(issues)
+	}
+
 	// Fills linters information for the JSON printer.
 	for _, lc := range c.dbManager.GetAllSupportedLinterConfigs() {
 		isEnabled := enabledLintersMap[lc.Name()] != nil
-		c.reportData.AddLinter(lc.Name(), isEnabled, lc.EnabledByDefault)
+		c.reportData.AddLinter(lc.Name(), lc.Linter.Desc(), lc.OriginalURL, isEnabled, lc.EnabledByDefault)
 	}
 
-	err = c.printer.Print(issues)
-	if err != nil {
-		return err
+	c.fillLintersRunData()
+
+	c.reportData.FingerprintVersion = result.StableFingerprintVersion
+	c.reportData.Version = c.buildInfo.Version
+	c.reportData.ConfigPath = c.cfg.GetConfigPath()
+	c.reportData.Duration = time.Since(startedAt)
+
+	// The streamed issues are already printed.
+	if !c.cfg.Output.Stream {
+		tracing.Track(ctx, "printing", func(_ context.Context) {
+			err = c.printer.Print(issues)
+		})
+		if err != nil {
+			return err
+		}
 	}
 
 	c.printStats(issues)
 
+	if lintErr != nil {
+		return lintErr
+	}
+
 	c.setExitCodeIfIssuesFound(issues)
 
 	c.fileCache.PrintStats(c.log)
@@ -371,25 +490,162 @@ func (c *runCommand) runAndPrint(ctx context.Context, args []string) error {
 	return nil
 }
 
+// hasFailedLinters returns true if some linters ran and failed.
+func (c *runCommand) hasFailedLinters() bool {
+	for _, run := range c.linterRuns {
+		if run.Err != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fillLintersRunData adds the hashes of the settings, the durations, and the errors of the enabled linters to the report.
+func (c *runCommand) fillLintersRunData() {
+	// The linters based on go/analysis run together (the durations of their analyzers are tracked).
+	durations := map[string]time.Duration{}
+	if c.timingReport != nil {
+		for _, lt := range c.timingReport.Data().Linters {
+			durations[lt.Name] = lt.Wall
+		}
+	}
+
+	for i := range c.reportData.Linters {
+		ld := &c.reportData.Linters[i]
+		if !ld.Enabled {
+			continue
+		}
+
+		hash, err := c.cfg.LintersSettings.Hash(ld.Name)
+		if err != nil {
+			c.log.Infof("Can't compute the settings hash of %s: %v", ld.Name, err)
+		}
+
+		ld.SettingsHash = hash
+		ld.Duration = durations[ld.Name]
+
+		run, ok := c.linterRuns[ld.Name]
+		if !ok {
+			continue
+		}
+
+		if run.Duration != 0 {
+			ld.Duration = run.Duration
+		}
+
+		if run.Err != nil {
+			ld.Error = run.Err.Error()
+		}
+	}
+}
+
 // runAnalysis executes the linters that have been enabled in the configuration.
 func (c *runCommand) runAnalysis(ctx context.Context, args []string) ([]result.Issue, error) {
+	if c.opts.Daemon {
+		issues, err := c.runDaemonAnalysis(ctx, args)
+		if !errors.Is(err, daemon.ErrNotRunning) {
+			return issues, err
+		}
+
+		c.log.Warnf("Running without the daemon: %v", err)
+	}
+
 	lintersToRun, err := c.dbManager.GetOptimizedLinters()
 	if err != nil {
 		return nil, err
 	}
 
-	lintCtx, err := c.contextBuilder.Build(ctx, c.log.Child(logutils.DebugKeyLintersContext), lintersToRun)
+	scopes, err := lint.NewScopes(c.cfg, c.dbManager)
+	if err != nil {
+		return nil, err
+	}
+
+	var lintCtx *linter.Context
+	tracing.Track(ctx, "packages loading", func(ctx context.Context) {
+		c.timingReport.TrackLoad(func() {
+			lintCtx, err = c.contextBuilder.Build(ctx, c.log.Child(logutils.DebugKeyLintersContext),
+				lint.LintersToLoad(lintersToRun, scopes))
+		})
+	})
 	if err != nil {
 		return nil, fmt.Errorf("context loading failed: %w", err)
 	}
 
+	lintCtx.TimingReport = c.timingReport
+
 	runner, err := lint.NewRunner(c.log.Child(logutils.DebugKeyRunner), c.cfg, args,
-		c.goenv, c.lineCache, c.fileCache, c.dbManager, lintCtx)
+		c.goenv, c.lineCache, c.fileCache, c.dbManager, lintCtx, scopes)
 	if err != nil {
 		return nil, err
 	}
 
-	return runner.Run(ctx, lintersToRun)
+	var issues []result.Issue
+	if c.cfg.Output.Stream {
+		issues, err = runner.RunStream(ctx, lintersToRun, c.printer.Stream)
+		err = errors.Join(err, c.printer.Close())
+	} else {
+		issues, err = runner.Run(ctx, lintersToRun)
+	}
+
+	c.linterRuns = runner.LinterRuns()
+	c.budgets = runner.Budgets()
+
+	// The budgets are lowered only if all the linters ran successfully on the whole project: the counts are complete.
+	if err == nil && c.reportData.Error == "" && c.cfg.Issues.RatchetBudgets {
+		if reason := partialRunReason(c.cfg, args); reason != "" {
+			c.log.Warnf("The budgets are not lowered: %s", reason)
+		} else if ratchetErr := c.ratchetBudgets(); ratchetErr != nil {
+			c.log.Warnf("Failed to lower the budgets: %s", ratchetErr)
+		}
+	}
+
+	// Used by `golangci-lint cache stats`.
+	if statsErr := c.pkgCache.SaveStats(); statsErr != nil {
+		c.log.Infof("Failed to save cache stats: %s", statsErr)
+	}
+
+	if c.opts.TimingReportPath != "" {
+		if reportErr := c.writeTimingReport(); reportErr != nil {
+			c.log.Warnf("Failed to write the timing report: %s", reportErr)
+		}
+	}
+
+	return issues, err
+}
+
+// writeTimingReport writes the JSON timing report to the file, and prints the most expensive linters and packages.
+func (c *runCommand) writeTimingReport() error {
+	const topCount = 10
+
+	data := c.timingReport.Data()
+
+	content, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(c.opts.TimingReportPath, content, 0o644)
+	if err != nil {
+		return fmt.Errorf("can't write file %s: %w", c.opts.TimingReportPath, err)
+	}
+
+	return data.PrintTop(logutils.StdErr, topCount)
+}
+
+// runDaemonAnalysis delegates the analysis to the daemon (`golangci-lint daemon`) of the working directory.
+// The configuration of the daemon is used.
+func (c *runCommand) runDaemonAnalysis(ctx context.Context, args []string) ([]result.Issue, error) {
+	if c.cfg.Issues.NeedFix || c.opts.CreateBaseline || c.cfg.Output.Stream {
+		return nil, fmt.Errorf("%w: the daemon doesn't fix the issues, create the baseline, or stream the issues", daemon.ErrNotRunning)
+	}
+
+	wd, err := fsutils.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("can't get working dir: %w", err)
+	}
+
+	return daemon.Lint(ctx, daemon.SocketPath(wd), &daemon.Request{WorkingDir: wd, Args: args})
 }
 
 func (c *runCommand) setOutputToDevNull() (savedStdout, savedStderr *os.File) {
@@ -404,9 +660,34 @@ func (c *runCommand) setOutputToDevNull() (savedStdout, savedStderr *os.File) {
 	return
 }
 
+// setExitCodeIfIssuesFound sets the exit code if some issues fail the run:
+// the issues below `issues.fail-on-severity`, the known issues with `issues.fail-on-new-only`,
+// and the issues within their budgets are only reported.
 func (c *runCommand) setExitCodeIfIssuesFound(issues []result.Issue) {
-	if len(issues) != 0 {
-		c.exitCode = c.cfg.Run.ExitCodeIfIssuesFound
+	failing := false
+	level := 0
+
+	for i := range issues {
+		if !c.cfg.Issues.IsFailing(issues[i].Severity, issues[i].Known) {
+			continue
+		}
+
+		if c.budgets != nil && c.budgets.Tolerates(&issues[i]) {
+			continue
+		}
+
+		failing = true
+		level = max(level, config.FailingSeverityLevel(issues[i].Severity))
+	}
+
+	if !failing {
+		return
+	}
+
+	c.exitCode = c.cfg.Run.ExitCodeIfIssuesFound
+
+	if c.cfg.Issues.SeverityExitCodes {
+		c.exitCode = severityExitCodes[config.SeverityLevels[level]]
 	}
 }
 
@@ -585,6 +866,15 @@ func setupRunPersistentFlags(fs *pflag.FlagSet, opts *runOptions) {
 	fs.StringVar(&opts.CPUProfilePath, "cpu-profile-path", "", color.GreenString("Path to CPU profile output file"))
 	fs.StringVar(&opts.MemProfilePath, "mem-profile-path", "", color.GreenString("Path to memory profile output file"))
 	fs.StringVar(&opts.TracePath, "trace-path", "", color.GreenString("Path to trace output file"))
+	fs.StringVar(&opts.TraceChromePath, "trace-chrome-path", "",
+		color.GreenString("Path to the output file of the spans of the run as Chrome trace events"))
+	fs.StringVar(&opts.TraceOTLPEndpoint, "trace-otlp-endpoint", "",
+		color.GreenString("URL of an OpenTelemetry collector receiving the spans of the run (OTLP/HTTP, ex: http://localhost:4318)"))
+	fs.StringVar(&opts.TimingReportPath, "timing-report", "",
+		color.GreenString("Path to the JSON report of the time spent by each linter on each package"))
+
+	fs.BoolVar(&opts.Daemon, "daemon", false,
+		color.GreenString("Delegate the analysis to the daemon of the current directory ('golangci-lint daemon')"))
 }
 
 func getDefaultConcurrency() int {
@@ -672,7 +962,7 @@ func computeBinarySalt(version string) ([]byte, error) {
 // computeConfigSalt computes configuration hash.
 // We don't hash all config fields to reduce meaningless cache invalidations.
 // At least, it has a huge impact on tests speed.
-// Fields: `LintersSettings` and `Run.BuildTags`.
+// Fields: `LintersSettings` (including the nested configurations) and `Run.BuildTags`.
 func computeConfigSalt(cfg *config.Config) ([]byte, error) {
 	lintersSettingsBytes, err := yaml.Marshal(cfg.LintersSettings)
 	if err != nil {
@@ -681,6 +971,16 @@ func computeConfigSalt(cfg *config.Config) ([]byte, error) {
 
 	configData := bytes.NewBufferString("linters-settings=")
 	configData.Write(lintersSettingsBytes)
+
+	for _, nested := range cfg.GetNestedConfigs() {
+		nestedSettingsBytes, err := yaml.Marshal(nested.LintersSettings)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal nested config linter settings: %w", err)
+		}
+
+		configData.WriteString("\n" + nested.GetConfigDir() + "=")
+		configData.Write(nestedSettingsBytes)
+	}
 	configData.WriteString("\nbuild-tags=%s" + strings.Join(cfg.Run.BuildTags, ","))
 
 	h := sha256.New()
@@ -0,0 +1,45 @@
+package commands
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/report"
+)
+
+func Test_fillLintersRunData(t *testing.T) {
+	c := &runCommand{
+		cfg: &config.Config{},
+		log: logutils.NewStderrLog(logutils.DebugKeyEmpty),
+		reportData: &report.Data{
+			Linters: []report.LinterData{
+				{Name: "errcheck", Enabled: true},
+				{Name: "govet", Enabled: true},
+				{Name: "gosec"},
+			},
+		},
+		linterRuns: map[string]lint.LinterRun{
+			"errcheck": {Duration: time.Second},
+			// The linters combined in the metalinter only have an error.
+			"govet": {Err: errors.New("analysis failed")},
+			"gosec": {Err: errors.New("not enabled")},
+		},
+	}
+
+	c.fillLintersRunData()
+
+	linters := c.reportData.Linters
+
+	assert.Equal(t, time.Second, linters[0].Duration)
+	assert.Empty(t, linters[0].Error)
+
+	assert.Equal(t, "analysis failed", linters[1].Error)
+
+	assert.Empty(t, linters[2].Error)
+}
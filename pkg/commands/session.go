@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/spf13/viper"
+
+	"github.com/golangci/golangci-lint/internal/pkgcache"
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/goanalysis/load"
+	"github.com/golangci/golangci-lint/pkg/goutil"
+	"github.com/golangci/golangci-lint/pkg/lint"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/timeutils"
+)
+
+// lintSession runs the linters several times inside a long-lived process (lsp, daemon):
+// the configuration is loaded once,
+// the packages, the facts and the issues of the unchanged packages are kept in memory.
+//
+// The linters are built again for each run: some linters keep their issues until the end of the run.
+type lintSession struct {
+	log logutils.Log
+	cfg *config.Config
+
+	dbManager *lintersdb.Manager
+	goenv     *goutil.Env
+	pkgCache  *pkgcache.Cache
+
+	// The loaded packages of each set of arguments.
+	mu       sync.Mutex
+	packages map[string]*lint.LoadedPackages
+}
+
+// setupLintSessionDefaults sets the default values of the options of `run` without flags in the sessions.
+func setupLintSessionDefaults(v *viper.Viper) {
+	v.SetDefault("output.uniq-by-line", true)
+}
+
+func newLintSession(log logutils.Log, cfg *config.Config, info BuildInfo) (*lintSession, error) {
+	// The files are never modified, and all the issues are reported.
+	cfg.Issues.NeedFix = false
+	cfg.Issues.FixOutput = ""
+	cfg.Issues.MaxIssuesPerLinter = 0
+	cfg.Issues.MaxSameIssues = 0
+
+	dbManager, err := lintersdb.NewManager(log.Child(logutils.DebugKeyLintersDB), cfg,
+		lintersdb.NewLinterBuilder(), lintersdb.NewPluginModuleBuilder(log), lintersdb.NewPluginGoBuilder(log))
+	if err != nil {
+		return nil, err
+	}
+
+	sw := timeutils.NewStopwatch("pkgcache", log.Child(logutils.DebugKeyStopwatch))
+
+	pkgCache, err := pkgcache.NewCache(sw, log.Child(logutils.DebugKeyPkgCache))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build packages cache: %w", err)
+	}
+
+	pkgCache.EnableMemoryLayer()
+
+	if err = initHashSalt(info.Version, cfg); err != nil {
+		return nil, fmt.Errorf("failed to init hash salt: %w", err)
+	}
+
+	return &lintSession{
+		log:       log,
+		cfg:       cfg,
+		dbManager: dbManager,
+		goenv:     goutil.NewEnv(log.Child(logutils.DebugKeyGoEnv)),
+		pkgCache:  pkgCache,
+		packages:  map[string]*lint.LoadedPackages{},
+	}, nil
+}
+
+func (s *lintSession) discoverGoEnv(ctx context.Context) {
+	if err := s.goenv.Discover(ctx); err != nil {
+		s.log.Warnf("Failed to discover go env: %s", err)
+	}
+}
+
+// changed marks a file as changed: its packages are loaded again by the next runs.
+// An empty path marks all the files as changed.
+func (s *lintSession) changed(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, loaded := range s.packages {
+		loaded.Invalidate(path)
+	}
+}
+
+func (s *lintSession) loadedPackages(args []string) *lint.LoadedPackages {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := strings.Join(args, "\x00")
+
+	loaded, ok := s.packages[key]
+	if !ok {
+		loaded = lint.NewLoadedPackages()
+		s.packages[key] = loaded
+	}
+
+	return loaded
+}
+
+// lint loads the packages and runs the linters on them:
+// only the packages of the changed files (see changed) are loaded again.
+// The runs must not be concurrent.
+func (s *lintSession) lint(ctx context.Context, args []string) ([]result.Issue, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Run.Timeout)
+	defer cancel()
+
+	// The files are changed between the runs.
+	fileCache := fsutils.NewFileCache()
+	lineCache := fsutils.NewLineCache(fileCache)
+
+	// The files are changed between the runs: the hashes of the changed packages and of their dependents are different.
+	s.pkgCache.ResetPackageHashes()
+
+	dbManager, err := s.dbManager.ForConfig(s.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	lintersToRun, err := dbManager.GetOptimizedLinters()
+	if err != nil {
+		return nil, err
+	}
+
+	scopes, err := lint.NewScopes(s.cfg, dbManager)
+	if err != nil {
+		return nil, err
+	}
+
+	guard := load.NewGuard()
+
+	pkgLoader := lint.NewPackageLoader(s.log.Child(logutils.DebugKeyLoader), s.cfg, args, s.goenv, guard)
+	pkgLoader.ReusePackages(s.loadedPackages(args))
+
+	contextBuilder := lint.NewContextBuilder(s.cfg, pkgLoader, fileCache, s.pkgCache, guard)
+
+	lintCtx, err := contextBuilder.Build(ctx, s.log.Child(logutils.DebugKeyLintersContext), lint.LintersToLoad(lintersToRun, scopes))
+	if err != nil {
+		return nil, fmt.Errorf("context loading failed: %w", err)
+	}
+
+	runner, err := lint.NewRunner(s.log.Child(logutils.DebugKeyRunner), s.cfg, args,
+		s.goenv, lineCache, fileCache, dbManager, lintCtx, scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	return runner.Run(ctx, lintersToRun)
+}
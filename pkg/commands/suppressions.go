@@ -0,0 +1,302 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result/processors"
+)
+
+type suppressionsOptions struct {
+	config.LoaderOptions
+
+	format string
+}
+
+type suppressionsCommand struct {
+	viper *viper.Viper
+	cmd   *cobra.Command
+
+	opts suppressionsOptions
+
+	cfg *config.Config
+
+	log logutils.Log
+}
+
+// suppression is an exclude rule or a nolint directive, with its metadata.
+type suppression struct {
+	Kind string `json:"kind"` // exclude-rule or nolint.
+
+	// The configuration file and the index of the exclude rule, or the position of the nolint directive.
+	Position string `json:"position"`
+
+	Linters []string `json:"linters,omitempty"`
+	Reason  string   `json:"reason,omitempty"`
+	Owner   string   `json:"owner,omitempty"`
+	Expires string   `json:"expires,omitempty"`
+	Issue   string   `json:"issue,omitempty"`
+	Expired bool     `json:"expired,omitempty"`
+	Invalid string   `json:"invalid,omitempty"` // The error of the invalid metadata (e.g. a malformed expiration date).
+}
+
+func newSuppression(kind, position string, linters []string, info *config.SuppressionInfo, now time.Time) suppression {
+	s := suppression{
+		Kind:     kind,
+		Position: position,
+		Linters:  linters,
+		Reason:   info.Reason,
+		Owner:    info.Owner,
+		Expires:  info.Expires,
+		Issue:    info.Issue,
+		Expired:  info.IsExpired(now),
+	}
+
+	if err := info.Validate(); err != nil {
+		s.Invalid = err.Error()
+	}
+
+	return s
+}
+
+func newSuppressionsCommand(logger logutils.Log) *suppressionsCommand {
+	c := &suppressionsCommand{
+		viper: viper.New(),
+		cfg:   config.NewDefault(),
+		log:   logger,
+	}
+
+	suppressionsCmd := &cobra.Command{
+		Use:   "suppressions [paths...]",
+		Short: "List the exclude rules and the nolint directives with their metadata",
+		Long: "List the exclude rules of the configuration files and the nolint directives of the Go files " +
+			"with their metadata (reason, owner, expiration date, and issue), to audit the suppressions.\n" +
+			"The metadata of a nolint directive are read from its explanation: " +
+			"`//nolint:errcheck // reason owner=<owner> expires=<YYYY-MM-DD> issue=<ticket>`.",
+		ValidArgsFunction: cobra.NoFileCompletions,
+		RunE:              c.execute,
+		PreRunE:           c.preRunE,
+		SilenceUsage:      true,
+	}
+
+	fs := suppressionsCmd.Flags()
+	fs.SortFlags = false // sort them as they are defined here
+
+	setupConfigFileFlagSet(fs, &c.opts.LoaderOptions)
+
+	fs.StringVar(&c.opts.format, "format", "text", color.GreenString("Output format: 'text' or 'json'"))
+
+	c.cmd = suppressionsCmd
+
+	return c
+}
+
+func (c *suppressionsCommand) preRunE(cmd *cobra.Command, args []string) error {
+	loader := config.NewLoader(c.log.Child(logutils.DebugKeyConfigReader), c.viper, cmd.Flags(), c.opts.LoaderOptions, c.cfg, args)
+
+	err := loader.Load(config.LoadOptions{Validation: true})
+	if err != nil {
+		return fmt.Errorf("can't load config: %w", err)
+	}
+
+	return nil
+}
+
+func (c *suppressionsCommand) execute(_ *cobra.Command, args []string) error {
+	now := time.Now()
+
+	suppressions := c.findExcludeRules(now)
+
+	directives, err := findNolintDirectives(args, now)
+	if err != nil {
+		return err
+	}
+
+	suppressions = append(suppressions, directives...)
+
+	switch c.opts.format {
+	case "json":
+		encoder := json.NewEncoder(logutils.StdOut)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(suppressions)
+
+	case "text":
+		for _, s := range suppressions {
+			printSuppression(s)
+		}
+
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported format %q", c.opts.format)
+	}
+}
+
+// findExcludeRules returns the exclude rules of the configuration and of the nested configurations.
+func (c *suppressionsCommand) findExcludeRules(now time.Time) []suppression {
+	var suppressions []suppression
+
+	configs := append([]*config.Config{c.cfg}, c.cfg.GetNestedConfigs()...)
+
+	for i, cfg := range configs {
+		// The exclude rules of a nested configuration are appended to the rules of its parent configuration.
+		offset := 0
+		if i > 0 {
+			parent := c.cfg
+			if index := c.cfg.NearestNestedConfig(filepath.Dir(cfg.GetConfigDir())); index != -1 {
+				parent = c.cfg.GetNestedConfigs()[index]
+			}
+
+			offset = len(parent.Issues.ExcludeRules)
+		}
+
+		for j := offset; j < len(cfg.Issues.ExcludeRules); j++ {
+			rule := cfg.Issues.ExcludeRules[j]
+
+			position := fmt.Sprintf("%s:issues.exclude-rules[%d]", cfg.GetConfigPath(), j-offset)
+
+			suppressions = append(suppressions, newSuppression("exclude-rule", position, rule.Linters, &rule.SuppressionInfo, now))
+		}
+	}
+
+	return suppressions
+}
+
+// findNolintDirectives returns the nolint directives of the Go files.
+// The paths are files or directories, `/...` includes the subdirectories.
+func findNolintDirectives(paths []string, now time.Time) ([]suppression, error) {
+	if len(paths) == 0 {
+		paths = []string{"./..."}
+	}
+
+	var suppressions []suppression
+
+	for _, path := range paths {
+		root, recursive := strings.CutSuffix(path, "/...")
+		if root == "" {
+			root = "."
+		}
+
+		err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if d.IsDir() {
+				if p == root {
+					return nil
+				}
+
+				name := d.Name()
+				if !recursive || name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+					return filepath.SkipDir
+				}
+
+				return nil
+			}
+
+			if filepath.Ext(p) != ".go" {
+				return nil
+			}
+
+			directives, err := findFileNolintDirectives(p, now)
+			if err != nil {
+				return err
+			}
+
+			suppressions = append(suppressions, directives...)
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return suppressions, nil
+}
+
+func findFileNolintDirectives(path string, now time.Time) ([]suppression, error) {
+	fset := token.NewFileSet()
+
+	f, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse %s: %w", path, err)
+	}
+
+	prettyPath, err := fsutils.ShortestRelPath(path, "")
+	if err != nil {
+		prettyPath = path
+	}
+
+	var suppressions []suppression
+
+	for _, group := range f.Comments {
+		for _, comment := range group.List {
+			directive := processors.ParseNolintDirective(comment.Text)
+			if directive == nil {
+				continue
+			}
+
+			position := fmt.Sprintf("%s:%d", prettyPath, fset.Position(comment.Pos()).Line)
+
+			suppressions = append(suppressions, newSuppression("nolint", position, directive.Linters, &directive.SuppressionInfo, now))
+		}
+	}
+
+	return suppressions, nil
+}
+
+func printSuppression(s suppression) {
+	linters := "all linters"
+	if len(s.Linters) != 0 {
+		linters = strings.Join(s.Linters, ", ")
+	}
+
+	var details []string
+
+	if s.Owner != "" {
+		details = append(details, "owner: "+s.Owner)
+	}
+
+	if s.Issue != "" {
+		details = append(details, "issue: "+s.Issue)
+	}
+
+	if s.Expires != "" {
+		expires := "expires: " + s.Expires
+		if s.Expired {
+			expires += " " + color.RedString("(expired)")
+		}
+
+		details = append(details, expires)
+	}
+
+	if s.Reason != "" {
+		details = append(details, "reason: "+s.Reason)
+	}
+
+	if s.Invalid != "" {
+		details = append(details, color.RedString("invalid: "+s.Invalid))
+	}
+
+	if len(details) == 0 {
+		details = append(details, color.YellowString("no metadata"))
+	}
+
+	_, _ = fmt.Fprintf(logutils.StdOut, "%s %s (%s): %s\n", color.YellowString(s.Position), s.Kind, linters, strings.Join(details, "; "))
+}
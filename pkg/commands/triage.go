@@ -0,0 +1,47 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/triage"
+)
+
+func newTriageCommand(logger logutils.Log, info BuildInfo) *runCommand {
+	// The triage runs the linters exactly like the run command (same flags and configuration),
+	// but the issues are reviewed one by one instead of being printed.
+	c := newRunCommand(logger, info)
+	c.opts.Triage = true
+	c.cmd.Use = "triage"
+	c.cmd.Short = "Review the issues one by one"
+	c.cmd.Long = "Review the issues one by one: each issue can be fixed, ignored with a nolint directive, " +
+		"or excluded with an exclude rule added to the configuration file.\n" +
+		"The issues of a file are reviewed from the bottom of the file to the top."
+
+	return c
+}
+
+// setupTriage configures the run to review the issues after the run of the linters.
+func (c *runCommand) setupTriage() {
+	// The issues are fixed one by one during the review.
+	c.cfg.Issues.NeedFix = false
+	c.cfg.Issues.FixOutput = ""
+
+	// Nothing is printed.
+	c.cfg.Output.Stream = false
+}
+
+// triage reviews the issues: the issues still present after the review set the exit code.
+func (c *runCommand) triage(issues []result.Issue) error {
+	session := triage.NewSession(c.log.Child(logutils.DebugKeyTriage), c.cfg.GetConfigPath(), os.Stdin, logutils.StdOut)
+
+	remaining, err := session.Run(issues)
+	if err != nil {
+		return err
+	}
+
+	c.setExitCodeIfIssuesFound(remaining)
+
+	return nil
+}
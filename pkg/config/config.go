@@ -1,6 +1,7 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strings"
 
@@ -10,7 +11,11 @@ import (
 
 // Config encapsulates the config data specified in the golangci-lint YAML config file.
 type Config struct {
-	cfgDir string // The directory containing the golangci-lint config file.
+	cfgDir  string // The directory containing the golangci-lint config file.
+	cfgPath string // The path of the golangci-lint config file.
+
+	nestedConfigs []*Config // The configurations of the subdirectories (`run.nested-configs`).
+	parent        *Config   // The configuration extended by a nested configuration (`run.nested-configs`).
 
 	Run Run `mapstructure:"run"`
 
@@ -30,6 +35,17 @@ func (c *Config) GetConfigDir() string {
 	return c.cfgDir
 }
 
+// GetConfigPath returns the path of the golangci config file.
+func (c *Config) GetConfigPath() string {
+	return c.cfgPath
+}
+
+// GetNestedConfigs returns the configurations of the subdirectories (`run.nested-configs`),
+// the configurations of the parent directories first.
+func (c *Config) GetNestedConfigs() []*Config {
+	return c.nestedConfigs
+}
+
 func (c *Config) Validate() error {
 	validators := []func() error{
 		c.Run.Validate,
@@ -38,6 +54,7 @@ func (c *Config) Validate() error {
 		c.Linters.Validate,
 		c.Issues.Validate,
 		c.Severity.Validate,
+		c.validateFixOutput,
 	}
 
 	for _, v := range validators {
@@ -49,6 +66,26 @@ func (c *Config) Validate() error {
 	return nil
 }
 
+// validateFixOutput checks that the diff of the fixes (`issues.fix-output`) is not mixed with the reports on stdout.
+func (c *Config) validateFixOutput() error {
+	if c.Issues.FixOutput == "" {
+		return nil
+	}
+
+	if _, path, _ := strings.Cut(c.Issues.FixOutput, ":"); path != "" && path != "stdout" {
+		return nil
+	}
+
+	for _, format := range c.Output.Formats {
+		if format.Path == "" || format.Path == "stdout" {
+			return fmt.Errorf("the diff of the fixes and the output format %q are both written to stdout: "+
+				"use fix-output 'diff:PATH', or write the output format to a file", format.Format)
+		}
+	}
+
+	return nil
+}
+
 func NewDefault() *Config {
 	return &Config{
 		LintersSettings: defaultLintersSettings,
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsGoGreaterThanOrEqual(t *testing.T) {
@@ -83,3 +84,83 @@ func TestIsGoGreaterThanOrEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestConfig_validateFixOutput(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		fixOutput string
+		formats   OutputFormats
+	}{
+		{
+			desc: "no fix output",
+			formats: OutputFormats{
+				{Format: OutFormatColoredLineNumber},
+			},
+		},
+		{
+			desc:      "diff to a file",
+			fixOutput: "diff:fixes.patch",
+			formats: OutputFormats{
+				{Format: OutFormatColoredLineNumber},
+			},
+		},
+		{
+			desc:      "outputs to files",
+			fixOutput: "diff",
+			formats: OutputFormats{
+				{Format: OutFormatJSON, Path: "report.json"},
+				{Format: OutFormatColoredLineNumber, Path: "stderr"},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &Config{
+				Issues: Issues{NeedFix: true, FixOutput: test.fixOutput},
+				Output: Output{Formats: test.formats},
+			}
+
+			require.NoError(t, cfg.validateFixOutput())
+		})
+	}
+}
+
+func TestConfig_validateFixOutput_error(t *testing.T) {
+	testCases := []struct {
+		desc      string
+		fixOutput string
+		formats   OutputFormats
+	}{
+		{
+			desc:      "diff and output to stdout",
+			fixOutput: "diff",
+			formats: OutputFormats{
+				{Format: OutFormatColoredLineNumber},
+			},
+		},
+		{
+			desc:      "explicit stdout",
+			fixOutput: "diff:stdout",
+			formats: OutputFormats{
+				{Format: OutFormatJSON, Path: "report.json"},
+				{Format: OutFormatColoredLineNumber, Path: "stdout"},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := &Config{
+				Issues: Issues{NeedFix: true, FixOutput: test.fixOutput},
+				Output: Output{Formats: test.formats},
+			}
+
+			require.ErrorContains(t, cfg.validateFixOutput(), "are both written to stdout")
+		})
+	}
+}
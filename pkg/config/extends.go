@@ -0,0 +1,353 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+)
+
+// extendsKey is the option containing the configuration files extended by a configuration file.
+const extendsKey = "extends"
+
+// appendedLists are the lists concatenated when a configuration file extends another configuration file:
+// the other lists are replaced.
+var appendedLists = []string{
+	"linters.enable",
+	"linters.disable",
+	"linters.presets",
+	"run.build-tags",
+	"issues.exclude",
+	"issues.include",
+	"issues.exclude-rules",
+	"issues.exclude-dirs",
+	"issues.exclude-files",
+	"issues.budgets",
+	"severity.rules",
+}
+
+// LoadResolved reads the configuration file and the configuration files it extends (`extends`),
+// and returns the merged settings.
+//
+// The extended configuration files are merged in order, then the configuration file is merged:
+//   - the maps are merged recursively,
+//   - the lists of appendedLists are concatenated (a linter enabled by a file is removed from the disabled linters, and vice versa),
+//   - the other values, including the other lists, are replaced.
+//
+// The values of the extended configuration files are used as is: their relative paths (e.g. `issues.exclude-rules[].path`,
+// `linters-settings.revive.config`) are not rebased, they are relative to the working directory
+// or to the directory of the configuration file, like the values of the configuration file.
+func LoadResolved(path string) (map[string]any, error) {
+	return resolveExtends(path, nil)
+}
+
+func resolveExtends(path string, stack []string) (map[string]any, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(stack, absPath) {
+		return nil, fmt.Errorf("circular extends: %s", strings.Join(append(stack, absPath), " -> "))
+	}
+
+	settings, err := readConfigFile(absPath)
+	if err != nil {
+		return nil, err
+	}
+
+	extends := toList(settings[extendsKey])
+	delete(settings, extendsKey)
+
+	resolved := map[string]any{}
+
+	for _, item := range extends {
+		extended, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid extends in %s: %v", path, item)
+		}
+
+		extendedPath, err := findExtendedFile(filepath.Dir(absPath), extended)
+		if err != nil {
+			return nil, fmt.Errorf("invalid extends in %s: %w", path, err)
+		}
+
+		base, err := resolveExtends(extendedPath, append(stack, absPath))
+		if err != nil {
+			return nil, err
+		}
+
+		resolved = mergeSettings(resolved, base, "")
+	}
+
+	resolved = mergeSettings(resolved, settings, "")
+
+	if len(extends) != 0 {
+		cleanLinters(resolved)
+	}
+
+	return resolved, nil
+}
+
+// findExtendedFile returns the path of an extended configuration file:
+// the path is relative to the directory of the configuration file, or to a Go module required by the project.
+// (e.g. `github.com/example/lint/base.yml` is the file `base.yml` of the module `github.com/example/lint`).
+// The modules are never downloaded: the module must be inside the module cache.
+func findExtendedFile(dir, extended string) (string, error) {
+	path, err := homedir.Expand(extended)
+	if err != nil {
+		return "", err
+	}
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	}
+
+	if modulePath := findModuleFile(dir, extended); modulePath != "" {
+		return modulePath, nil
+	}
+
+	return "", fmt.Errorf("can't find the configuration file %q", extended)
+}
+
+// findModuleFile returns the path of the file inside the directory of a required module, or an empty string.
+func findModuleFile(dir, extended string) string {
+	parts := strings.Split(filepath.ToSlash(extended), "/")
+
+	// The first element of a module path is a domain name.
+	if len(parts) < 2 || !strings.Contains(parts[0], ".") {
+		return ""
+	}
+
+	for i := len(parts) - 1; i > 0; i-- {
+		cmd := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", strings.Join(parts[:i], "/"))
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GOPROXY=off", "GOFLAGS=-mod=readonly")
+
+		out, err := cmd.Output()
+		if err != nil {
+			continue
+		}
+
+		moduleDir := strings.TrimSpace(string(out))
+		if moduleDir == "" {
+			continue
+		}
+
+		path := filepath.Join(append([]string{moduleDir}, parts[i:]...)...)
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+
+	return ""
+}
+
+// readConfigFile reads a YAML, TOML, or JSON configuration file.
+// The keys are converted to lower case (like Viper).
+func readConfigFile(path string) (map[string]any, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := map[string]any{}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(content, &settings)
+	case ".toml":
+		err = toml.Unmarshal(content, &settings)
+	case ".yml", ".yaml", "":
+		err = yaml.Unmarshal(content, &settings)
+	default:
+		return nil, fmt.Errorf("unsupported configuration file format: %s", path)
+	}
+
+	if err != nil {
+		prettyPath, _ := fsutils.ShortestRelPath(path, "")
+		return nil, fmt.Errorf("can't read the configuration file %s: %w", prettyPath, err)
+	}
+
+	return lowerKeys(settings).(map[string]any), nil
+}
+
+// mergeSettings merges the settings into the base settings.
+func mergeSettings(base, settings map[string]any, prefix string) map[string]any {
+	if prefix == "" && base["linters"] != nil && settings["linters"] != nil {
+		removeOverriddenLinters(base["linters"], settings["linters"])
+	}
+
+	for key, value := range settings {
+		fullKey := key
+		if prefix != "" {
+			fullKey = prefix + "." + key
+		}
+
+		baseMap, baseIsMap := base[key].(map[string]any)
+		valueMap, valueIsMap := value.(map[string]any)
+
+		switch {
+		case baseIsMap && valueIsMap:
+			base[key] = mergeSettings(baseMap, valueMap, fullKey)
+
+		case slices.Contains(appendedLists, fullKey) && base[key] != nil:
+			base[key] = appendItems(toList(base[key]), toList(value))
+
+		default:
+			base[key] = value
+		}
+	}
+
+	return base
+}
+
+// removeOverriddenLinters removes the linters enabled by the settings from the disabled linters of the base settings,
+// and the linters disabled by the settings from the enabled linters of the base settings.
+// The options `enable-all` and `disable-all` of the settings also override the opposite option of the base settings.
+func removeOverriddenLinters(base, settings any) {
+	baseLinters, ok := base.(map[string]any)
+	if !ok {
+		return
+	}
+
+	linters, ok := settings.(map[string]any)
+	if !ok {
+		return
+	}
+
+	for key, opposite := range map[string]string{"enable": "disable", "disable": "enable"} {
+		if linters[key+"-all"] == true {
+			delete(baseLinters, opposite+"-all")
+		}
+
+		if baseLinters[opposite] == nil || linters[key] == nil {
+			continue
+		}
+
+		overridden := toList(linters[key])
+
+		baseLinters[opposite] = slices.DeleteFunc(toList(baseLinters[opposite]), func(item any) bool {
+			return slices.Contains(overridden, item)
+		})
+	}
+}
+
+// cleanLinters removes the lists of linters useless with `enable-all` or `disable-all`:
+// the linters disabled by a configuration file and enabled by an extended configuration file are already removed.
+func cleanLinters(settings map[string]any) {
+	linters, ok := settings["linters"].(map[string]any)
+	if !ok {
+		return
+	}
+
+	if linters["disable-all"] == true {
+		delete(linters, "disable")
+	}
+
+	if linters["enable-all"] == true {
+		delete(linters, "enable")
+	}
+}
+
+// appendItems appends the items, the duplicated strings are ignored.
+func appendItems(list, items []any) []any {
+	for _, item := range items {
+		if _, ok := item.(string); ok && slices.Contains(list, item) {
+			continue
+		}
+
+		list = append(list, item)
+	}
+
+	return list
+}
+
+// toList converts a value into a list: a string is a comma-separated list (like the string slice options).
+func toList(value any) []any {
+	switch v := value.(type) {
+	case nil:
+		return nil
+
+	case []any:
+		return slices.Clone(v)
+
+	case string:
+		var list []any
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); s != "" {
+				list = append(list, s)
+			}
+		}
+
+		return list
+
+	default:
+		return []any{v}
+	}
+}
+
+func lowerKeys(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		lowered := make(map[string]any, len(v))
+		for key, item := range v {
+			lowered[strings.ToLower(key)] = lowerKeys(item)
+		}
+
+		return lowered
+
+	case []any:
+		for i, item := range v {
+			v[i] = lowerKeys(item)
+		}
+
+		return v
+
+	default:
+		return v
+	}
+}
+
+// handleExtends replaces the settings of the configuration file with the resolved settings (`extends`).
+func (l *Loader) handleExtends() error {
+	if !l.viper.InConfig(extendsKey) {
+		return nil
+	}
+
+	usedConfigFile := l.viper.ConfigFileUsed()
+	if usedConfigFile == os.Stdin.Name() {
+		return errors.New("the option `extends` can't be used when the configuration is read from stdin")
+	}
+
+	settings, err := LoadResolved(usedConfigFile)
+	if err != nil {
+		return err
+	}
+
+	l.log.Infof("Config file %s extends %v", usedConfigFile, l.viper.Get(extendsKey))
+
+	content, err := yaml.Marshal(settings)
+	if err != nil {
+		return err
+	}
+
+	l.viper.SetConfigType("yaml")
+
+	return l.viper.ReadConfig(bytes.NewReader(content))
+}
@@ -0,0 +1,214 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadResolved(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile(t, filepath.Join(dir, "shared", "base.yml"), `
+linters:
+  disable-all: true
+  enable:
+    - errcheck
+    - govet
+    - misspell
+issues:
+  exclude-rules:
+    - path: _test\.go
+      linters:
+        - errcheck
+linters-settings:
+  misspell:
+    locale: US
+    ignore-words:
+      - foo
+`)
+
+	writeFile(t, filepath.Join(dir, "shared", "extra.toml"), `
+[linters]
+enable = ["gosec"]
+
+[linters-settings.misspell]
+ignore-words = ["bar"]
+`)
+
+	writeFile(t, filepath.Join(dir, "project", ".golangci.yml"), `
+extends:
+  - ../shared/base.yml
+  - ../shared/extra.toml
+linters:
+  enable:
+    - govet
+    - revive
+  disable:
+    - misspell
+issues:
+  exclude-rules:
+    - text: "some text"
+      linters:
+        - revive
+`)
+
+	settings, err := LoadResolved(filepath.Join(dir, "project", ".golangci.yml"))
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"linters": map[string]any{
+			"disable-all": true,
+			"enable":      []any{"errcheck", "govet", "gosec", "revive"},
+		},
+		"issues": map[string]any{
+			"exclude-rules": []any{
+				map[string]any{"path": `_test\.go`, "linters": []any{"errcheck"}},
+				map[string]any{"text": "some text", "linters": []any{"revive"}},
+			},
+		},
+		"linters-settings": map[string]any{
+			"misspell": map[string]any{
+				"locale":       "US",
+				"ignore-words": []any{"bar"},
+			},
+		},
+	}
+
+	assert.Equal(t, expected, settings)
+}
+
+func TestLoadResolved_nested(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile(t, filepath.Join(dir, "a.yml"), `
+run:
+  timeout: 1m
+  build-tags:
+    - a
+`)
+
+	writeFile(t, filepath.Join(dir, "b.yml"), `
+extends: a.yml
+run:
+  build-tags:
+    - b
+`)
+
+	writeFile(t, filepath.Join(dir, "c.yml"), `
+extends: b.yml
+run:
+  timeout: 5m
+`)
+
+	settings, err := LoadResolved(filepath.Join(dir, "c.yml"))
+	require.NoError(t, err)
+
+	expected := map[string]any{
+		"run": map[string]any{
+			"timeout":    "5m",
+			"build-tags": []any{"a", "b"},
+		},
+	}
+
+	assert.Equal(t, expected, settings)
+}
+
+func TestLoadResolved_error(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile(t, filepath.Join(dir, "a.yml"), "extends: b.yml\n")
+	writeFile(t, filepath.Join(dir, "b.yml"), "extends: a.yml\n")
+	writeFile(t, filepath.Join(dir, "c.yml"), "extends: missing.yml\n")
+
+	testCases := []struct {
+		desc     string
+		path     string
+		expected string
+	}{
+		{
+			desc: "circular extends",
+			path: "a.yml",
+			expected: "circular extends: " + filepath.Join(dir, "a.yml") + " -> " +
+				filepath.Join(dir, "b.yml") + " -> " + filepath.Join(dir, "a.yml"),
+		},
+		{
+			desc:     "missing file",
+			path:     "c.yml",
+			expected: `invalid extends in ` + filepath.Join(dir, "c.yml") + `: can't find the configuration file "missing.yml"`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := LoadResolved(filepath.Join(dir, test.path))
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func Test_mergeSettings(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		base     map[string]any
+		settings map[string]any
+		expected map[string]any
+	}{
+		{
+			desc:     "replace scalar",
+			base:     map[string]any{"run": map[string]any{"timeout": "1m", "tests": false}},
+			settings: map[string]any{"run": map[string]any{"timeout": "5m"}},
+			expected: map[string]any{"run": map[string]any{"timeout": "5m", "tests": false}},
+		},
+		{
+			desc:     "replace list",
+			base:     map[string]any{"linters-settings": map[string]any{"gci": map[string]any{"sections": []any{"standard"}}}},
+			settings: map[string]any{"linters-settings": map[string]any{"gci": map[string]any{"sections": []any{"default"}}}},
+			expected: map[string]any{"linters-settings": map[string]any{"gci": map[string]any{"sections": []any{"default"}}}},
+		},
+		{
+			desc:     "append list",
+			base:     map[string]any{"issues": map[string]any{"exclude-dirs": []any{"a", "b"}}},
+			settings: map[string]any{"issues": map[string]any{"exclude-dirs": "b,c"}},
+			expected: map[string]any{"issues": map[string]any{"exclude-dirs": []any{"a", "b", "c"}}},
+		},
+		{
+			desc:     "enable disabled linter",
+			base:     map[string]any{"linters": map[string]any{"disable": []any{"gosec", "revive"}}},
+			settings: map[string]any{"linters": map[string]any{"enable": []any{"gosec"}}},
+			expected: map[string]any{"linters": map[string]any{"enable": []any{"gosec"}, "disable": []any{"revive"}}},
+		},
+		{
+			desc:     "enable all linters",
+			base:     map[string]any{"linters": map[string]any{"disable-all": true, "enable": []any{"gosec"}}},
+			settings: map[string]any{"linters": map[string]any{"enable-all": true}},
+			expected: map[string]any{"linters": map[string]any{"enable-all": true, "enable": []any{"gosec"}}},
+		},
+		{
+			desc:     "new key",
+			base:     map[string]any{"run": map[string]any{"timeout": "1m"}},
+			settings: map[string]any{"output": map[string]any{"sort-results": true}},
+			expected: map[string]any{"run": map[string]any{"timeout": "1m"}, "output": map[string]any{"sort-results": true}},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, mergeSettings(test.base, test.settings, ""))
+		})
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
@@ -4,10 +4,17 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 )
 
 const excludeRuleMinConditionsCount = 2
 
+// DefaultBaselinePath is the baseline file created by `golangci-lint baseline create` when `issues.baseline` is not set.
+const DefaultBaselinePath = ".golangci-baseline.json"
+
+// FixOutputDiff is the fix output writing a unified diff of the fixes instead of modifying the files.
+const FixOutputDiff = "diff"
+
 var DefaultExcludePatterns = []ExcludePattern{
 	{
 		ID: "EXC0001",
@@ -125,7 +132,22 @@ type Issues struct {
 	WholeFiles        bool   `mapstructure:"whole-files"`
 	Diff              bool   `mapstructure:"new"`
 
-	NeedFix bool `mapstructure:"fix"`
+	NeedFix   bool   `mapstructure:"fix"`
+	FixOutput string `mapstructure:"fix-output"`
+
+	Baseline           string `mapstructure:"baseline"`
+	NeedBaselineUpdate bool   `mapstructure:"-"` // Set by `golangci-lint baseline create`.
+
+	Budgets        []Budget `mapstructure:"budgets"`
+	RatchetBudgets bool     `mapstructure:"ratchet-budgets"`
+
+	FailOnSeverity    string `mapstructure:"fail-on-severity"`
+	FailOnNewOnly     bool   `mapstructure:"fail-on-new-only"`
+	SeverityExitCodes bool   `mapstructure:"severity-exit-codes"`
+
+	FailOnExpiredSuppressions bool `mapstructure:"fail-on-expired-suppressions"`
+
+	ReportUnusedExclusions bool `mapstructure:"report-unused-exclusions"`
 
 	ExcludeGeneratedStrict bool `mapstructure:"exclude-generated-strict"` // Deprecated: use ExcludeGenerated instead.
 }
@@ -137,14 +159,75 @@ func (i *Issues) Validate() error {
 		}
 	}
 
+	if i.FixOutput != "" {
+		if !i.NeedFix {
+			return errors.New("fix should be 'true' to use fix-output")
+		}
+
+		if format, _, _ := strings.Cut(i.FixOutput, ":"); format != FixOutputDiff {
+			return fmt.Errorf("unsupported fix-output %q", i.FixOutput)
+		}
+	}
+
+	for i, budget := range i.Budgets {
+		if err := budget.Validate(); err != nil {
+			return fmt.Errorf("error in budget #%d: %w", i, err)
+		}
+	}
+
+	if i.FailOnSeverity != "" && SeverityLevel(i.FailOnSeverity) < 0 {
+		return fmt.Errorf("unsupported fail-on-severity %q: the supported severities are: %s",
+			i.FailOnSeverity, strings.Join(SeverityLevels, ", "))
+	}
+
+	return nil
+}
+
+// IsFailing returns true if the issue with the severity fails the run.
+// A known issue (in the baseline or outside the diff) only fails the run if `fail-on-new-only` is disabled.
+// An issue without severity, or with an unknown severity, is considered as an error: it can't be hidden by `fail-on-severity`.
+func (i *Issues) IsFailing(severity string, known bool) bool {
+	if known && i.FailOnNewOnly {
+		return false
+	}
+
+	if i.FailOnSeverity == "" {
+		return true
+	}
+
+	return FailingSeverityLevel(severity) >= SeverityLevel(i.FailOnSeverity)
+}
+
+// Budget is the maximum number of issues of the linters in the files matching the path.
+// The issues within their budgets are reported but don't fail the run.
+type Budget struct {
+	Linters []string
+	Path    string // Glob: `*` matches any sequence of characters except `/`, `**` matches any sequence of characters.
+	Max     int
+}
+
+func (b *Budget) Validate() error {
+	if len(b.Linters) == 0 && b.Path == "" {
+		return errors.New("at least 1 of (linters, path) should be set")
+	}
+
+	if b.Max < 0 {
+		return fmt.Errorf("max should be positive or zero: %d", b.Max)
+	}
+
 	return nil
 }
 
 type ExcludeRule struct {
-	BaseRule `mapstructure:",squash"`
+	BaseRule        `mapstructure:",squash"`
+	SuppressionInfo `mapstructure:",squash"`
 }
 
 func (e *ExcludeRule) Validate() error {
+	if err := e.SuppressionInfo.Validate(); err != nil {
+		return err
+	}
+
 	return e.BaseRule.Validate(excludeRuleMinConditionsCount)
 }
 
@@ -39,7 +39,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 		{
 			desc: "only path rule",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					Path: "test",
 				},
 			},
@@ -48,7 +48,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 		{
 			desc: "only path-except rule",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					PathExcept: "test",
 				},
 			},
@@ -57,7 +57,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 		{
 			desc: "only text rule",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					Text: "test",
 				},
 			},
@@ -66,7 +66,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 		{
 			desc: "only source rule",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					Source: "test",
 				},
 			},
@@ -75,7 +75,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 		{
 			desc: "invalid path rule",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					Path: "**test",
 				},
 			},
@@ -84,7 +84,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 		{
 			desc: "invalid path-except rule",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					PathExcept: "**test",
 				},
 			},
@@ -93,7 +93,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 		{
 			desc: "invalid text rule",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					Text: "**test",
 				},
 			},
@@ -102,7 +102,7 @@ func TestExcludeRule_Validate(t *testing.T) {
 		{
 			desc: "invalid source rule",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					Source: "**test",
 				},
 			},
@@ -111,13 +111,26 @@ func TestExcludeRule_Validate(t *testing.T) {
 		{
 			desc: "path and path-expect",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					Path:       "test",
 					PathExcept: "test",
 				},
 			},
 			expected: "path and path-except should not be set at the same time",
 		},
+		{
+			desc: "invalid expires",
+			rule: &ExcludeRule{
+				BaseRule: BaseRule{
+					Path: "test",
+					Text: "test",
+				},
+				SuppressionInfo: SuppressionInfo{
+					Expires: "31/12/2024",
+				},
+			},
+			expected: `invalid expires date "31/12/2024": the expected format is YYYY-MM-DD`,
+		},
 	}
 
 	for _, test := range testCases {
@@ -138,7 +151,7 @@ func TestExcludeRule_Validate_error(t *testing.T) {
 		{
 			desc: "path and linter",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					Path:    "test",
 					Linters: []string{"a"},
 				},
@@ -147,7 +160,7 @@ func TestExcludeRule_Validate_error(t *testing.T) {
 		{
 			desc: "path-except and linter",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					PathExcept: "test",
 					Linters:    []string{"a"},
 				},
@@ -156,7 +169,7 @@ func TestExcludeRule_Validate_error(t *testing.T) {
 		{
 			desc: "text and linter",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					Text:    "test",
 					Linters: []string{"a"},
 				},
@@ -165,7 +178,7 @@ func TestExcludeRule_Validate_error(t *testing.T) {
 		{
 			desc: "source and linter",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					Source:  "test",
 					Linters: []string{"a"},
 				},
@@ -174,7 +187,7 @@ func TestExcludeRule_Validate_error(t *testing.T) {
 		{
 			desc: "path and text",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					Path: "test",
 					Text: "test",
 				},
@@ -183,13 +196,28 @@ func TestExcludeRule_Validate_error(t *testing.T) {
 		{
 			desc: "path and text and linter",
 			rule: &ExcludeRule{
-				BaseRule{
+				BaseRule: BaseRule{
 					Path:    "test",
 					Text:    "test",
 					Linters: []string{"a"},
 				},
 			},
 		},
+		{
+			desc: "path and linter with metadata",
+			rule: &ExcludeRule{
+				BaseRule: BaseRule{
+					Path:    "test",
+					Linters: []string{"a"},
+				},
+				SuppressionInfo: SuppressionInfo{
+					Reason:  "legacy code",
+					Owner:   "team-a",
+					Expires: "2024-12-31",
+					Issue:   "PROJ-123",
+				},
+			},
+		},
 	}
 
 	for _, test := range testCases {
@@ -201,3 +229,183 @@ func TestExcludeRule_Validate_error(t *testing.T) {
 		})
 	}
 }
+
+func TestIssues_Validate(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		settings *Issues
+	}{
+		{
+			desc:     "empty",
+			settings: &Issues{},
+		},
+		{
+			desc: "fix-output diff",
+			settings: &Issues{
+				NeedFix:   true,
+				FixOutput: "diff",
+			},
+		},
+		{
+			desc: "fix-output diff with path",
+			settings: &Issues{
+				NeedFix:   true,
+				FixOutput: "diff:path/to/fixes.patch",
+			},
+		},
+		{
+			desc: "fail-on-severity",
+			settings: &Issues{
+				FailOnSeverity: "warning",
+			},
+		},
+		{
+			desc: "budgets",
+			settings: &Issues{
+				Budgets: []Budget{
+					{Linters: []string{"errcheck"}, Max: 0},
+					{Path: "legacy/**", Max: 12},
+				},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.settings.Validate()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestIssues_Validate_error(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		settings *Issues
+		expected string
+	}{
+		{
+			desc: "fix-output without fix",
+			settings: &Issues{
+				FixOutput: "diff",
+			},
+			expected: "fix should be 'true' to use fix-output",
+		},
+		{
+			desc: "unsupported fix-output",
+			settings: &Issues{
+				NeedFix:   true,
+				FixOutput: "json",
+			},
+			expected: `unsupported fix-output "json"`,
+		},
+		{
+			desc: "budget without conditions",
+			settings: &Issues{
+				Budgets: []Budget{{Max: 1}},
+			},
+			expected: "error in budget #0: at least 1 of (linters, path) should be set",
+		},
+		{
+			desc: "negative budget",
+			settings: &Issues{
+				Budgets: []Budget{{Path: "legacy/**", Max: -1}},
+			},
+			expected: "error in budget #0: max should be positive or zero: -1",
+		},
+		{
+			desc: "unsupported fail-on-severity",
+			settings: &Issues{
+				FailOnSeverity: "foo",
+			},
+			expected: `unsupported fail-on-severity "foo": the supported severities are: hint, info, warning, error`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.settings.Validate()
+			require.EqualError(t, err, test.expected)
+		})
+	}
+}
+
+func TestIssues_IsFailing(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		settings *Issues
+		severity string
+		known    bool
+		expected bool
+	}{
+		{
+			desc:     "default",
+			settings: &Issues{},
+			expected: true,
+		},
+		{
+			desc:     "known issue",
+			settings: &Issues{},
+			known:    true,
+			expected: true,
+		},
+		{
+			desc:     "known issue with fail-on-new-only",
+			settings: &Issues{FailOnNewOnly: true},
+			known:    true,
+			expected: false,
+		},
+		{
+			desc:     "new issue with fail-on-new-only",
+			settings: &Issues{FailOnNewOnly: true},
+			expected: true,
+		},
+		{
+			desc:     "same severity",
+			settings: &Issues{FailOnSeverity: "warning"},
+			severity: "warning",
+			expected: true,
+		},
+		{
+			desc:     "higher severity",
+			settings: &Issues{FailOnSeverity: "warning"},
+			severity: "error",
+			expected: true,
+		},
+		{
+			desc:     "lower severity",
+			settings: &Issues{FailOnSeverity: "warning"},
+			severity: "info",
+			expected: false,
+		},
+		{
+			desc:     "converted severity",
+			settings: &Issues{FailOnSeverity: "warning"},
+			severity: "high",
+			expected: true,
+		},
+		{
+			desc:     "unknown severity",
+			settings: &Issues{FailOnSeverity: "error"},
+			severity: "foo",
+			expected: true,
+		},
+		{
+			desc:     "no severity",
+			settings: &Issues{FailOnSeverity: "error"},
+			expected: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, test.settings.IsFailing(test.severity, test.known))
+		})
+	}
+}
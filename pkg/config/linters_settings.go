@@ -1,10 +1,14 @@
 package config
 
 import (
+	"crypto/sha256"
 	"encoding"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"reflect"
 	"runtime"
+	"strings"
 
 	"gopkg.in/yaml.v3"
 )
@@ -295,6 +299,37 @@ func (s *LintersSettings) Validate() error {
 	return nil
 }
 
+// Hash returns the SHA-256 of the settings of the linter.
+// Returns an empty string if the linter has no settings.
+func (s *LintersSettings) Hash(name string) (string, error) {
+	settings, ok := s.Custom[name]
+	if ok {
+		return hashSettings(settings)
+	}
+
+	// The names of the fields are the names of the linters (case-insensitive, like the configuration keys).
+	v := reflect.ValueOf(s).Elem()
+
+	for i := range v.NumField() {
+		if strings.EqualFold(v.Type().Field(i).Name, name) {
+			return hashSettings(v.Field(i).Interface())
+		}
+	}
+
+	return "", nil
+}
+
+func hashSettings(settings any) (string, error) {
+	b, err := yaml.Marshal(settings)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal linter settings: %w", err)
+	}
+
+	h := sha256.Sum256(b)
+
+	return hex.EncodeToString(h[:]), nil
+}
+
 type AsasalintSettings struct {
 	Exclude              []string `mapstructure:"exclude"`
 	UseBuiltinExclusions bool     `mapstructure:"use-builtin-exclusions"`
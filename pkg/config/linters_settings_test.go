@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLintersSettings_Validate(t *testing.T) {
@@ -83,6 +84,35 @@ func TestLintersSettings_Validate_error(t *testing.T) {
 	}
 }
 
+func TestLintersSettings_Hash(t *testing.T) {
+	settings := &LintersSettings{
+		Gocritic: GoCriticSettings{EnabledChecks: []string{"a"}},
+		Custom: map[string]CustomLinterSettings{
+			"example": {Type: "module"},
+		},
+	}
+
+	hash, err := settings.Hash("gocritic")
+	require.NoError(t, err)
+	assert.Len(t, hash, 64)
+
+	other := &LintersSettings{
+		Gocritic: GoCriticSettings{EnabledChecks: []string{"b"}},
+	}
+
+	otherHash, err := other.Hash("gocritic")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, otherHash)
+
+	hash, err = settings.Hash("example")
+	require.NoError(t, err)
+	assert.Len(t, hash, 64)
+
+	hash, err = settings.Hash("unknown")
+	require.NoError(t, err)
+	assert.Empty(t, hash)
+}
+
 func TestCustomLinterSettings_Validate(t *testing.T) {
 	testCases := []struct {
 		desc     string
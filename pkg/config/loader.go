@@ -92,6 +92,13 @@ func (l *Loader) Load(opts LoadOptions) error {
 		}
 	}
 
+	if l.cfg.Run.NestedConfigs {
+		err = l.loadNestedConfigs(opts)
+		if err != nil {
+			return fmt.Errorf("can't load the nested configuration files: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -208,7 +215,12 @@ func (l *Loader) parseConfig() error {
 		return fmt.Errorf("can't read viper config: %w", err)
 	}
 
-	err := l.setConfigDir()
+	err := l.handleExtends()
+	if err != nil {
+		return fmt.Errorf("can't resolve the extended configuration files: %w", err)
+	}
+
+	err = l.setConfigDir()
 	if err != nil {
 		return err
 	}
@@ -251,6 +263,7 @@ func (l *Loader) setConfigDir() error {
 	}
 
 	l.cfg.cfgDir = usedConfigDir
+	l.cfg.cfgPath = usedConfigFile
 
 	return nil
 }
@@ -0,0 +1,217 @@
+package config
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+)
+
+// nestedConfigNames are the names of the configuration files of the subdirectories (`run.nested-configs`).
+var nestedConfigNames = []string{".golangci.yml", ".golangci.yaml", ".golangci.toml", ".golangci.json"}
+
+// NearestNestedConfig returns the index of the nested configuration of the nearest directory containing the path,
+// or -1 if the path is outside the directories of the nested configurations.
+// A relative path is relative to the working directory.
+func (c *Config) NearestNestedConfig(path string) int {
+	if len(c.nestedConfigs) == 0 {
+		return -1
+	}
+
+	if !filepath.IsAbs(path) {
+		wd, err := fsutils.Getwd()
+		if err != nil {
+			return -1
+		}
+
+		path = filepath.Join(wd, path)
+	}
+
+	nearest := -1
+
+	for i, nested := range c.nestedConfigs {
+		if !isInDir(path, nested.cfgDir) {
+			continue
+		}
+
+		if nearest == -1 || len(nested.cfgDir) > len(c.nestedConfigs[nearest].cfgDir) {
+			nearest = i
+		}
+	}
+
+	return nearest
+}
+
+// loadNestedConfigs loads the configuration files of the subdirectories of the working directory (`run.nested-configs`).
+//
+// The configuration of a subdirectory extends the configuration of the nearest parent directory:
+// the settings are merged like the extended configuration files (`extends`).
+// The options of the sections `run` and `output` can't be overridden.
+func (l *Loader) loadNestedConfigs(opts LoadOptions) error {
+	wd, err := fsutils.Getwd()
+	if err != nil {
+		return err
+	}
+
+	paths, err := findNestedConfigs(wd)
+	if err != nil {
+		return err
+	}
+
+	rootPath, _ := filepath.Abs(l.viper.ConfigFileUsed())
+
+	for _, path := range paths {
+		if path == rootPath {
+			continue
+		}
+
+		cfg, err := l.loadNestedConfig(path)
+		if err != nil {
+			return err
+		}
+
+		if opts.Validation {
+			err = cfg.Validate()
+			if err != nil {
+				return fmt.Errorf("invalid configuration %s: %w", cfg.cfgPath, err)
+			}
+		}
+
+		l.log.Infof("Used nested config file %s", cfg.cfgPath)
+
+		l.cfg.nestedConfigs = append(l.cfg.nestedConfigs, cfg)
+	}
+
+	return nil
+}
+
+func (l *Loader) loadNestedConfig(path string) (*Config, error) {
+	dir := filepath.Dir(path)
+
+	prettyPath, err := fsutils.ShortestRelPath(path, "")
+	if err != nil {
+		prettyPath = path
+	}
+
+	settings := l.viper.AllSettings()
+
+	parentCfg := l.cfg
+
+	// The configurations of the parent directories are loaded first.
+	for _, parent := range l.cfg.nestedConfigs {
+		if !isInDir(dir, parent.cfgDir) {
+			continue
+		}
+
+		parentCfg = parent
+
+		parentSettings, err := LoadResolved(parent.cfgPath)
+		if err != nil {
+			return nil, err
+		}
+
+		settings = mergeSettings(settings, parentSettings, "")
+	}
+
+	nestedSettings, err := LoadResolved(path)
+	if err != nil {
+		return nil, err
+	}
+
+	// The budgets count the issues of the whole project: they are only evaluated with the root configuration.
+	if issues, ok := nestedSettings["issues"].(map[string]any); ok && issues["budgets"] != nil {
+		return nil, fmt.Errorf("the budgets (`issues.budgets`) can only be set in the root configuration: %s", prettyPath)
+	}
+
+	settings = mergeSettings(settings, nestedSettings, "")
+
+	cleanLinters(settings)
+
+	v := viper.New()
+
+	err = v.MergeConfigMap(settings)
+	if err != nil {
+		return nil, fmt.Errorf("can't read the configuration file %s: %w", prettyPath, err)
+	}
+
+	cfg := NewDefault()
+
+	err = v.Unmarshal(cfg, customDecoderHook())
+	if err != nil {
+		return nil, fmt.Errorf("can't unmarshal config by viper (%s): %w", prettyPath, err)
+	}
+
+	cfg.cfgDir = dir
+	cfg.cfgPath = prettyPath
+	cfg.parent = parentCfg
+
+	nestedLoader := NewLoader(l.log, v, l.fs, l.opts, cfg, l.args)
+
+	// The flags apply to the nested configurations too.
+	nestedLoader.applyStringSliceHack()
+
+	err = nestedLoader.handleEnableOnlyOption()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg.Run = l.cfg.Run
+	cfg.Output = l.cfg.Output
+
+	nestedLoader.handleGoVersion()
+
+	return cfg, nil
+}
+
+// findNestedConfigs returns the paths of the configuration files inside the subdirectories of the directory,
+// the files of the parent directories first.
+// The directories ignored by the Go tools (`vendor`, `testdata`, and the names starting with `.` or `_`) are skipped.
+func findNestedConfigs(root string) ([]string, error) {
+	var paths []string
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() || path == root {
+			return nil
+		}
+
+		name := d.Name()
+		if name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+			return filepath.SkipDir
+		}
+
+		for _, configName := range nestedConfigNames {
+			configPath := filepath.Join(path, configName)
+
+			if _, err := os.Stat(configPath); err == nil {
+				paths = append(paths, configPath)
+				break
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return paths, nil
+}
+
+// isInDir returns true if the path is the directory or is inside the directory.
+func isInDir(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,169 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+func Test_findNestedConfigs(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	writeFile(t, filepath.Join(dir, ".golangci.yml"), "")
+	writeFile(t, filepath.Join(dir, "a", ".golangci.yml"), "")
+	writeFile(t, filepath.Join(dir, "a", "b", ".golangci.toml"), "")
+	writeFile(t, filepath.Join(dir, "c", "file.go"), "")
+	writeFile(t, filepath.Join(dir, "d", ".golangci.json"), "")
+	writeFile(t, filepath.Join(dir, "vendor", "e", ".golangci.yml"), "")
+	writeFile(t, filepath.Join(dir, "testdata", ".golangci.yml"), "")
+	writeFile(t, filepath.Join(dir, ".hidden", ".golangci.yml"), "")
+	writeFile(t, filepath.Join(dir, "_ignored", ".golangci.yml"), "")
+
+	paths, err := findNestedConfigs(dir)
+	require.NoError(t, err)
+
+	expected := []string{
+		filepath.Join(dir, "a", ".golangci.yml"),
+		filepath.Join(dir, "a", "b", ".golangci.toml"),
+		filepath.Join(dir, "d", ".golangci.json"),
+	}
+
+	assert.Equal(t, expected, paths)
+}
+
+func TestLoader_loadNestedConfig(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	writeFile(t, filepath.Join(dir, "a", ".golangci.yml"), `
+linters:
+  disable:
+    - errcheck
+  enable:
+    - revive
+linters-settings:
+  misspell:
+    locale: UK
+issues:
+  exclude-rules:
+    - path: generated
+      linters:
+        - revive
+`)
+
+	writeFile(t, filepath.Join(dir, "a", "b", ".golangci.yml"), `
+linters:
+  enable:
+    - errcheck
+severity:
+  default-severity: info
+`)
+
+	v := viper.New()
+	require.NoError(t, v.MergeConfigMap(map[string]any{
+		"run": map[string]any{"go": "1.22", "nested-configs": true},
+		"linters": map[string]any{
+			"disable-all": true,
+			"enable":      []any{"errcheck", "govet"},
+		},
+		"linters-settings": map[string]any{
+			"misspell": map[string]any{"locale": "US", "ignore-words": []any{"foo"}},
+		},
+		"issues": map[string]any{
+			"exclude-rules": []any{map[string]any{"text": "foo", "linters": []any{"govet"}}},
+		},
+	}))
+
+	cfg := NewDefault()
+	require.NoError(t, v.Unmarshal(cfg, customDecoderHook()))
+
+	loader := NewLoader(logutils.NewMockLog(), v, pflag.NewFlagSet("test", pflag.ContinueOnError), LoaderOptions{}, cfg, nil)
+
+	nestedA, err := loader.loadNestedConfig(filepath.Join(dir, "a", ".golangci.yml"))
+	require.NoError(t, err)
+
+	cfg.nestedConfigs = append(cfg.nestedConfigs, nestedA)
+
+	nestedB, err := loader.loadNestedConfig(filepath.Join(dir, "a", "b", ".golangci.yml"))
+	require.NoError(t, err)
+
+	cfg.nestedConfigs = append(cfg.nestedConfigs, nestedB)
+
+	// Root configuration.
+	assert.Equal(t, []string{"errcheck", "govet"}, cfg.Linters.Enable)
+	assert.Equal(t, "US", cfg.LintersSettings.Misspell.Locale)
+
+	// Nested configuration of `a`.
+	assert.Equal(t, filepath.Join(dir, "a"), nestedA.GetConfigDir())
+	assert.True(t, nestedA.Linters.DisableAll)
+	assert.Equal(t, []string{"govet", "revive"}, nestedA.Linters.Enable)
+	assert.Empty(t, nestedA.Linters.Disable) // Useless with `disable-all`.
+	assert.Equal(t, "UK", nestedA.LintersSettings.Misspell.Locale)
+	assert.Equal(t, []string{"foo"}, nestedA.LintersSettings.Misspell.IgnoreWords)
+	assert.Len(t, nestedA.Issues.ExcludeRules, 2)
+	assert.Equal(t, "1.22", nestedA.Run.Go)
+	assert.Equal(t, "1.22", nestedA.LintersSettings.Gosimple.GoVersion)
+
+	// Nested configuration of `a/b`: extends the nested configuration of `a`.
+	assert.Equal(t, []string{"govet", "revive", "errcheck"}, nestedB.Linters.Enable)
+	assert.Empty(t, nestedB.Linters.Disable)
+	assert.Equal(t, "UK", nestedB.LintersSettings.Misspell.Locale)
+	assert.Equal(t, "info", nestedB.Severity.Default)
+	assert.Empty(t, nestedA.Severity.Default)
+
+	// The root configuration is not modified.
+	assert.Equal(t, []string{"errcheck", "govet"}, cfg.Linters.Enable)
+	assert.Len(t, cfg.Issues.ExcludeRules, 1)
+
+	testCases := []struct {
+		path     string
+		expected int
+	}{
+		{path: filepath.Join(dir, "main.go"), expected: -1},
+		{path: filepath.Join(dir, "a", "main.go"), expected: 0},
+		{path: filepath.Join(dir, "a", "c", "main.go"), expected: 0},
+		{path: filepath.Join(dir, "a", "b", "main.go"), expected: 1},
+		{path: filepath.Join(dir, "a", "b", "c", "main.go"), expected: 1},
+		{path: filepath.Join(dir, "ab", "main.go"), expected: -1},
+	}
+
+	for _, test := range testCases {
+		assert.Equal(t, test.expected, cfg.NearestNestedConfig(test.path), test.path)
+	}
+}
+
+func TestLoader_loadNestedConfig_budgets(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	writeFile(t, filepath.Join(dir, "a", ".golangci.yml"), `
+issues:
+  budgets:
+    - linters:
+        - errcheck
+      max: 3
+`)
+
+	v := viper.New()
+	require.NoError(t, v.MergeConfigMap(map[string]any{
+		"run": map[string]any{"nested-configs": true},
+	}))
+
+	cfg := NewDefault()
+	require.NoError(t, v.Unmarshal(cfg, customDecoderHook()))
+
+	loader := NewLoader(logutils.NewMockLog(), v, pflag.NewFlagSet("test", pflag.ContinueOnError), LoaderOptions{}, cfg, nil)
+
+	_, err := loader.loadNestedConfig(filepath.Join(dir, "a", ".golangci.yml"))
+	require.ErrorContains(t, err, "the budgets (`issues.budgets`) can only be set in the root configuration")
+}
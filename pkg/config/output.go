@@ -9,6 +9,7 @@ import (
 
 const (
 	OutFormatJSON              = "json"
+	OutFormatJSONV2            = "json-v2"
 	OutFormatLineNumber        = "line-number"
 	OutFormatColoredLineNumber = "colored-line-number"
 	OutFormatTab               = "tab"
@@ -21,10 +22,18 @@ const (
 	OutFormatGithubActions     = "github-actions" // Deprecated
 	OutFormatTeamCity          = "teamcity"
 	OutFormatSarif             = "sarif"
+	OutFormatRDJSON            = "rdjson"
+	OutFormatGitLab            = "gitlab"
+	OutFormatMarkdown          = "markdown"
+	OutFormatNDJSON            = "ndjson"
+
+	// OutFormatTemplate is the prefix of the formats rendered by a Go template: `template:<path of the template>`.
+	OutFormatTemplate = "template"
 )
 
 var AllOutputFormats = []string{
 	OutFormatJSON,
+	OutFormatJSONV2,
 	OutFormatLineNumber,
 	OutFormatColoredLineNumber,
 	OutFormatTab,
@@ -37,6 +46,21 @@ var AllOutputFormats = []string{
 	OutFormatGithubActions,
 	OutFormatTeamCity,
 	OutFormatSarif,
+	OutFormatRDJSON,
+	OutFormatGitLab,
+	OutFormatMarkdown,
+	OutFormatNDJSON,
+}
+
+// StreamableOutputFormats are the formats that can be printed while the linters are running (`output.stream`).
+var StreamableOutputFormats = []string{
+	OutFormatLineNumber,
+	OutFormatColoredLineNumber,
+	OutFormatTab,
+	OutFormatColoredTab,
+	OutFormatGithubActions,
+	OutFormatTeamCity,
+	OutFormatNDJSON,
 }
 
 type Output struct {
@@ -48,6 +72,9 @@ type Output struct {
 	SortOrder       []string      `mapstructure:"sort-order"`
 	PathPrefix      string        `mapstructure:"path-prefix"`
 	ShowStats       bool          `mapstructure:"show-stats"`
+	Stream          bool          `mapstructure:"stream"`
+
+	Markdown MarkdownSettings `mapstructure:"markdown"`
 
 	// Deprecated: use Formats instead.
 	Format string `mapstructure:"format"`
@@ -77,11 +104,23 @@ func (o *Output) Validate() error {
 		if err != nil {
 			return err
 		}
+
+		if o.Stream && !slices.Contains(StreamableOutputFormats, format.Format) {
+			return fmt.Errorf("the output format %q can't be streamed, the streamable formats are: %s",
+				format.Format, strings.Join(StreamableOutputFormats, ", "))
+		}
 	}
 
 	return nil
 }
 
+// MarkdownSettings are the settings of the markdown format.
+type MarkdownSettings struct {
+	// The issues are linked to `<permalink-base-url>/<revision>/<path>#L<line>` (ex: https://github.com/org/repo/blob).
+	PermalinkBaseURL string `mapstructure:"permalink-base-url"`
+	Revision         string `mapstructure:"revision"`
+}
+
 type OutputFormat struct {
 	Format string `mapstructure:"format"`
 	Path   string `mapstructure:"path"`
@@ -92,6 +131,14 @@ func (o *OutputFormat) Validate() error {
 		return errors.New("the format is required")
 	}
 
+	if kind, templatePath, ok := strings.Cut(o.Format, ":"); ok && kind == OutFormatTemplate {
+		if templatePath == "" {
+			return fmt.Errorf("the path of the template is required: %s:<path>", OutFormatTemplate)
+		}
+
+		return nil
+	}
+
 	if !slices.Contains(AllOutputFormats, o.Format) {
 		return fmt.Errorf("unsupported output format %q", o.Format)
 	}
@@ -99,6 +146,16 @@ func (o *OutputFormat) Validate() error {
 	return nil
 }
 
+// TemplatePath returns the path of the template of a `template:<path>` format.
+func (o *OutputFormat) TemplatePath() (string, bool) {
+	kind, templatePath, ok := strings.Cut(o.Format, ":")
+	if !ok || kind != OutFormatTemplate {
+		return "", false
+	}
+
+	return templatePath, true
+}
+
 type OutputFormats []OutputFormat
 
 func (p *OutputFormats) UnmarshalText(text []byte) error {
@@ -107,6 +164,14 @@ func (p *OutputFormats) UnmarshalText(text []byte) error {
 	for _, item := range formats {
 		format, path, _ := strings.Cut(item, ":")
 
+		// `template:<path of the template>[:<path of the output>]`
+		if format == OutFormatTemplate {
+			var templatePath string
+			templatePath, path, _ = cutPath(path)
+
+			format += ":" + templatePath
+		}
+
 		*p = append(*p, OutputFormat{
 			Path:   path,
 			Format: format,
@@ -115,3 +180,23 @@ func (p *OutputFormats) UnmarshalText(text []byte) error {
 
 	return nil
 }
+
+// cutPath slices the string around the first ":" after the path,
+// the ":" of a Windows drive (e.g. `C:\report.tmpl`) is a part of the path.
+func cutPath(s string) (path, after string, found bool) {
+	start := 0
+	if len(s) > 2 && isDriveLetter(s[0]) && s[1] == ':' && (s[2] == '\\' || s[2] == '/') {
+		start = 2
+	}
+
+	i := strings.Index(s[start:], ":")
+	if i < 0 {
+		return s, "", false
+	}
+
+	return s[:start+i], s[start+i+1:], true
+}
+
+func isDriveLetter(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z'
+}
@@ -39,6 +39,16 @@ func TestOutput_Validate(t *testing.T) {
 				SortOrder:   []string{"file", "linter", "severity"},
 			},
 		},
+		{
+			desc: "stream",
+			settings: &Output{
+				Formats: []OutputFormat{
+					{Format: "colored-line-number"},
+					{Format: "ndjson", Path: "./report.ndjson"},
+				},
+				Stream: true,
+			},
+		},
 	}
 
 	for _, test := range testCases {
@@ -91,6 +101,18 @@ func TestOutput_Validate_error(t *testing.T) {
 			},
 			expected: `unsupported output format "test"`,
 		},
+		{
+			desc: "stream and unstreamable format",
+			settings: &Output{
+				Formats: []OutputFormat{
+					{Format: "line-number"},
+					{Format: "json"},
+				},
+				Stream: true,
+			},
+			expected: `the output format "json" can't be streamed, the streamable formats are: ` +
+				"line-number, colored-line-number, tab, colored-tab, github-actions, teamcity, ndjson",
+		},
 	}
 
 	for _, test := range testCases {
@@ -103,6 +125,25 @@ func TestOutput_Validate_error(t *testing.T) {
 	}
 }
 
+func TestOutputFormats_UnmarshalText(t *testing.T) {
+	var formats OutputFormats
+
+	err := formats.UnmarshalText([]byte("json:report.json,template:report.tmpl,template:report.tmpl:report.csv," +
+		`template:C:\report.tmpl,template:C:\report.tmpl:D:\report.csv,template:c:/report.tmpl:report.csv`))
+	require.NoError(t, err)
+
+	expected := OutputFormats{
+		{Format: "json", Path: "report.json"},
+		{Format: "template:report.tmpl"},
+		{Format: "template:report.tmpl", Path: "report.csv"},
+		{Format: `template:C:\report.tmpl`},
+		{Format: `template:C:\report.tmpl`, Path: `D:\report.csv`},
+		{Format: "template:c:/report.tmpl", Path: "report.csv"},
+	}
+
+	require.Equal(t, expected, formats)
+}
+
 func TestOutputFormat_Validate(t *testing.T) {
 	testCases := []struct {
 		desc     string
@@ -128,6 +169,13 @@ func TestOutputFormat_Validate(t *testing.T) {
 				Path:   "/tmp/example.json",
 			},
 		},
+		{
+			desc: "template",
+			settings: &OutputFormat{
+				Format: "template:./report.tmpl",
+				Path:   "./report.csv",
+			},
+		},
 	}
 
 	for _, test := range testCases {
@@ -158,6 +206,13 @@ func TestOutputFormat_Validate_error(t *testing.T) {
 			},
 			expected: `unsupported output format "test"`,
 		},
+		{
+			desc: "template without path",
+			settings: &OutputFormat{
+				Format: "template:",
+			},
+			expected: "the path of the template is required: template:<path>",
+		},
 	}
 
 	for _, test := range testCases {
@@ -0,0 +1,210 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+)
+
+// listItem is an item of a list option with its position in the configuration files.
+type listItem struct {
+	value    any
+	position string
+	source   ListSource
+}
+
+// ListSource is the configuration file defining an item of a list option.
+type ListSource struct {
+	// Path of the configuration file.
+	Path string
+	// Index of the item in the list option of the configuration file.
+	Index int
+}
+
+// ListPositions returns the positions of the items of a list option (e.g. `issues.exclude-rules`),
+// in the order of the items of the option: `path:line` for the YAML configuration files, `path` for the other formats.
+// The items of the extended configuration files (`extends`) and of the parent configurations (`run.nested-configs`) are included.
+// The positions of the items coming from the other sources (e.g. the flags) are empty.
+func (c *Config) ListPositions(key string) []string {
+	items := c.listItems(strings.Split(key, "."))
+
+	positions := make([]string, len(items))
+	for i, item := range items {
+		positions[i] = item.position
+	}
+
+	return positions
+}
+
+// ListSources returns the configuration files defining the items of a list option (e.g. `issues.budgets`),
+// in the order of the items of the option (see ListPositions).
+// The items coming from the other sources (e.g. the flags) have an empty source.
+func (c *Config) ListSources(key string) []ListSource {
+	items := c.listItems(strings.Split(key, "."))
+
+	sources := make([]ListSource, len(items))
+	for i, item := range items {
+		sources[i] = item.source
+	}
+
+	return sources
+}
+
+func (c *Config) listItems(keys []string) []listItem {
+	var items []listItem
+
+	if c.parent != nil {
+		items = c.parent.listItems(keys)
+	}
+
+	if c.cfgPath != "" {
+		items = appendListItems(items, fileListItems(c.cfgPath, keys, 0))
+	}
+
+	return items
+}
+
+// fileListItems returns the items of a list option of a configuration file and of the configuration files it extends.
+// The configuration was already loaded: the errors are ignored.
+func fileListItems(path string, keys []string, depth int) []listItem {
+	// Protects against circular extends.
+	if depth > 100 {
+		return nil
+	}
+
+	settings, err := readConfigFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var items []listItem
+
+	for _, item := range toList(settings[extendsKey]) {
+		extended, ok := item.(string)
+		if !ok {
+			continue
+		}
+
+		extendedPath, err := findExtendedFile(filepath.Dir(path), extended)
+		if err != nil {
+			continue
+		}
+
+		items = appendListItems(items, fileListItems(extendedPath, keys, depth+1))
+	}
+
+	var value any = settings
+	for _, key := range keys {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return items
+		}
+
+		value = m[key]
+	}
+
+	prettyPath, err := fsutils.ShortestRelPath(path, "")
+	if err != nil {
+		prettyPath = path
+	}
+
+	lines := yamlListLines(path, keys)
+
+	var own []listItem
+
+	for i, v := range toList(value) {
+		position := prettyPath
+		if i < len(lines) {
+			position = fmt.Sprintf("%s:%d", prettyPath, lines[i])
+		}
+
+		own = append(own, listItem{value: v, position: position, source: ListSource{Path: path, Index: i}})
+	}
+
+	return appendListItems(items, own)
+}
+
+// yamlListLines returns the lines of the items of a list option of a YAML configuration file.
+// Returns nil for the other formats.
+func yamlListLines(path string, keys []string) []int {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml", "":
+	default:
+		return nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var doc yaml.Node
+
+	err = yaml.Unmarshal(content, &doc)
+	if err != nil || len(doc.Content) == 0 {
+		return nil
+	}
+
+	node := doc.Content[0]
+
+	for _, key := range keys {
+		node = yamlMappingValue(node, key)
+		if node == nil {
+			return nil
+		}
+	}
+
+	if node.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	lines := make([]int, len(node.Content))
+	for i, item := range node.Content {
+		lines[i] = item.Line
+	}
+
+	return lines
+}
+
+// yamlMappingValue returns the value of a key of a mapping node (the keys are case-insensitive, like Viper), or nil.
+func yamlMappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if strings.EqualFold(node.Content[i].Value, key) {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+// appendListItems appends the items like appendItems: the duplicated strings are ignored.
+func appendListItems(list, items []listItem) []listItem {
+	for _, item := range items {
+		if s, ok := item.value.(string); ok && containsListValue(list, s) {
+			continue
+		}
+
+		list = append(list, item)
+	}
+
+	return list
+}
+
+func containsListValue(list []listItem, value string) bool {
+	for _, item := range list {
+		if item.value == value {
+			return true
+		}
+	}
+
+	return false
+}
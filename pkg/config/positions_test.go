@@ -0,0 +1,82 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+)
+
+func TestConfig_ListPositions(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	writeFile(t, filepath.Join(dir, "base.yml"), `
+issues:
+  exclude:
+    - foo
+  exclude-rules:
+    - path: base
+      linters:
+        - errcheck
+`)
+
+	writeFile(t, filepath.Join(dir, ".golangci.yml"), `
+extends:
+  - base.yml
+issues:
+  exclude:
+    - foo
+    - bar
+  exclude-rules:
+    - path: root
+      linters:
+        - errcheck
+
+    - text: root
+      linters:
+        - govet
+`)
+
+	writeFile(t, filepath.Join(dir, "sub", ".golangci.json"), `{"issues": {"exclude-rules": [{"path": "sub", "linters": ["errcheck"]}]}}`)
+
+	root := &Config{cfgPath: filepath.Join(dir, ".golangci.yml")}
+	nested := &Config{cfgPath: filepath.Join(dir, "sub", ".golangci.json"), parent: root}
+
+	prettyPath := func(elem ...string) string {
+		path, err := fsutils.ShortestRelPath(filepath.Join(append([]string{dir}, elem...)...), "")
+		require.NoError(t, err)
+
+		return path
+	}
+
+	// The duplicated patterns are ignored, like in the merged configuration.
+	assert.Equal(t, []string{prettyPath("base.yml") + ":4", prettyPath(".golangci.yml") + ":7"}, root.ListPositions("issues.exclude"))
+
+	expected := []string{
+		prettyPath("base.yml") + ":6",
+		prettyPath(".golangci.yml") + ":9",
+		prettyPath(".golangci.yml") + ":13",
+	}
+
+	assert.Equal(t, expected, root.ListPositions("issues.exclude-rules"))
+
+	// The lines are only available for the YAML files.
+	assert.Equal(t, append(expected, prettyPath("sub", ".golangci.json")), nested.ListPositions("issues.exclude-rules"))
+
+	assert.Empty(t, root.ListPositions("issues.exclude-dirs"))
+	assert.Empty(t, (&Config{}).ListPositions("issues.exclude-rules"))
+
+	expectedSources := []ListSource{
+		{Path: filepath.Join(dir, "base.yml"), Index: 0},
+		{Path: filepath.Join(dir, ".golangci.yml"), Index: 0},
+		{Path: filepath.Join(dir, ".golangci.yml"), Index: 1},
+		{Path: filepath.Join(dir, "sub", ".golangci.json"), Index: 0},
+	}
+
+	assert.Equal(t, expectedSources, nested.ListSources("issues.exclude-rules"))
+}
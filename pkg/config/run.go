@@ -24,6 +24,8 @@ type Run struct {
 	AllowParallelRunners bool `mapstructure:"allow-parallel-runners"`
 	AllowSerialRunners   bool `mapstructure:"allow-serial-runners"`
 
+	NestedConfigs bool `mapstructure:"nested-configs"`
+
 	// Deprecated: use Issues.ExcludeFiles instead.
 	SkipFiles []string `mapstructure:"skip-files"`
 	// Deprecated: use Issues.ExcludeDirs instead.
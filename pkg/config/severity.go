@@ -3,10 +3,56 @@ package config
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 )
 
 const severityRuleMinConditionsCount = 1
 
+// The normalized severities.
+const (
+	SeverityError   = "error"
+	SeverityWarning = "warning"
+	SeverityInfo    = "info"
+	SeverityHint    = "hint"
+)
+
+// SeverityLevels are the normalized severities: the position inside the slice defines the importance (lower to higher).
+var SeverityLevels = []string{SeverityHint, SeverityInfo, SeverityWarning, SeverityError}
+
+// SeverityLevel returns the importance of the severity, -1 if the severity is unknown.
+func SeverityLevel(severity string) int {
+	return slices.Index(SeverityLevels, NormalizeSeverity(severity))
+}
+
+// FailingSeverityLevel returns the importance of the severity of a failing issue:
+// the issues without severity, or with an unknown severity, are errors.
+func FailingSeverityLevel(severity string) int {
+	if level := SeverityLevel(severity); level >= 0 {
+		return level
+	}
+
+	return SeverityLevel(SeverityError)
+}
+
+// NormalizeSeverity converts a severity (from a linter, a configuration, or an output format)
+// into one of the normalized severities: error, warning, info, hint.
+// It returns an empty string if the severity is unknown.
+func NormalizeSeverity(severity string) string {
+	switch strings.ToLower(strings.TrimSpace(severity)) {
+	case "error", "fatal", "blocker", "critical", "high":
+		return SeverityError
+	case "warning", "warn", "major", "medium":
+		return SeverityWarning
+	case "info", "information", "notice", "note", "minor", "low":
+		return SeverityInfo
+	case "hint", "suggestion", "weak warning":
+		return SeverityHint
+	default:
+		return ""
+	}
+}
+
 type Severity struct {
 	Default       string         `mapstructure:"default-severity"`
 	CaseSensitive bool           `mapstructure:"case-sensitive"`
@@ -3,6 +3,7 @@ package config
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -200,3 +201,48 @@ func TestSeverityRule_Validate_error(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeSeverity(t *testing.T) {
+	testCases := []struct {
+		severity string
+		expected string
+	}{
+		{severity: "error", expected: SeverityError},
+		{severity: "high", expected: SeverityError},
+		{severity: "Critical", expected: SeverityError},
+		{severity: "warning", expected: SeverityWarning},
+		{severity: "medium", expected: SeverityWarning},
+		{severity: "major", expected: SeverityWarning},
+		{severity: "info", expected: SeverityInfo},
+		{severity: "low", expected: SeverityInfo},
+		{severity: "note", expected: SeverityInfo},
+		{severity: "hint", expected: SeverityHint},
+		{severity: "weak warning", expected: SeverityHint},
+		{severity: "", expected: ""},
+		{severity: "foo", expected: ""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.severity, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, NormalizeSeverity(test.severity))
+		})
+	}
+}
+
+func TestSeverityLevel(t *testing.T) {
+	assert.Equal(t, 0, SeverityLevel("hint"))
+	assert.Equal(t, 1, SeverityLevel("low"))
+	assert.Equal(t, 2, SeverityLevel("warning"))
+	assert.Equal(t, 3, SeverityLevel("high"))
+	assert.Equal(t, -1, SeverityLevel("foo"))
+}
+
+func TestFailingSeverityLevel(t *testing.T) {
+	assert.Equal(t, 0, FailingSeverityLevel("hint"))
+	assert.Equal(t, 2, FailingSeverityLevel("warning"))
+	assert.Equal(t, 3, FailingSeverityLevel("error"))
+	assert.Equal(t, 3, FailingSeverityLevel("foo"))
+	assert.Equal(t, 3, FailingSeverityLevel(""))
+}
@@ -0,0 +1,64 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// suppressionExpiresLayout is the format of the expiration dates of the suppressions.
+const suppressionExpiresLayout = "2006-01-02"
+
+// SuppressionInfo is the metadata of a suppression (an exclude rule or a nolint directive),
+// used to audit the suppressions.
+type SuppressionInfo struct {
+	Reason  string `mapstructure:"reason"`
+	Owner   string `mapstructure:"owner"`
+	Expires string `mapstructure:"expires"` // YYYY-MM-DD: the suppression expires at the end of the day.
+	Issue   string `mapstructure:"issue"`
+}
+
+func (s *SuppressionInfo) Validate() error {
+	if s.Expires == "" {
+		return nil
+	}
+
+	if _, err := time.Parse(suppressionExpiresLayout, s.Expires); err != nil {
+		return fmt.Errorf("invalid expires date %q: the expected format is YYYY-MM-DD", s.Expires)
+	}
+
+	return nil
+}
+
+// IsExpired returns true if the suppression has an expiration date before the day of now.
+// An invalid expiration date never expires: it is reported by Validate.
+func (s *SuppressionInfo) IsExpired(now time.Time) bool {
+	if s.Expires == "" {
+		return false
+	}
+
+	date, err := time.ParseInLocation(suppressionExpiresLayout, s.Expires, now.Location())
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(date.AddDate(0, 0, 1))
+}
+
+func (s *SuppressionInfo) String() string {
+	var parts []string
+
+	if s.Owner != "" {
+		parts = append(parts, "owner: "+s.Owner)
+	}
+
+	if s.Issue != "" {
+		parts = append(parts, "issue: "+s.Issue)
+	}
+
+	if s.Reason != "" {
+		parts = append(parts, "reason: "+s.Reason)
+	}
+
+	return strings.Join(parts, "; ")
+}
@@ -0,0 +1,67 @@
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuppressionInfo_IsExpired(t *testing.T) {
+	now := time.Date(2024, time.June, 15, 10, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		desc     string
+		expires  string
+		expected bool
+	}{
+		{
+			desc:     "no expiration date",
+			expires:  "",
+			expected: false,
+		},
+		{
+			desc:     "future",
+			expires:  "2024-06-16",
+			expected: false,
+		},
+		{
+			desc:     "today",
+			expires:  "2024-06-15",
+			expected: false,
+		},
+		{
+			desc:     "yesterday",
+			expires:  "2024-06-14",
+			expected: true,
+		},
+		{
+			desc:     "invalid date",
+			expires:  "2024/06/14",
+			expected: false,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			info := &SuppressionInfo{Expires: test.expires}
+
+			assert.Equal(t, test.expected, info.IsExpired(now))
+		})
+	}
+}
+
+func TestSuppressionInfo_String(t *testing.T) {
+	info := &SuppressionInfo{
+		Reason:  "legacy code",
+		Owner:   "team-a",
+		Expires: "2024-12-31",
+		Issue:   "PROJ-123",
+	}
+
+	assert.Equal(t, "owner: team-a; issue: PROJ-123; reason: legacy code", info.String())
+
+	assert.Empty(t, (&SuppressionInfo{Expires: "2024-12-31"}).String())
+}
@@ -0,0 +1,38 @@
+package daemon
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// ErrNotRunning is returned by the client when no daemon is listening on the socket.
+var ErrNotRunning = errors.New("the daemon is not running")
+
+// LintFunc lints the packages matching the arguments (`golangci-lint run` arguments).
+type LintFunc func(ctx context.Context, args []string) ([]result.Issue, error)
+
+// Request is sent by the client (`golangci-lint run --daemon`).
+type Request struct {
+	WorkingDir string   `json:"workingDir"`
+	Args       []string `json:"args"`
+}
+
+// Response is sent by the daemon.
+type Response struct {
+	Issues []result.Issue `json:"issues,omitempty"`
+	Error  string         `json:"error,omitempty"`
+}
+
+// SocketPath returns the path of the unix socket of the daemon of the working directory.
+// The path is short enough for the limits of the unix socket paths.
+func SocketPath(wd string) string {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d\x00%s", os.Getuid(), wd)))
+
+	return filepath.Join(os.TempDir(), fmt.Sprintf("golangci-lint-daemon-%x.sock", hash[:8]))
+}
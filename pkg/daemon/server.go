@@ -0,0 +1,155 @@
+package daemon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// Server lints the packages on behalf of `golangci-lint run --daemon`.
+//
+// The issues of the last run of each set of arguments are reused as long as no file changed.
+// Otherwise, only the changed packages and their dependents are analyzed again:
+// the facts and the issues of the other packages are kept in memory by the packages cache.
+type Server struct {
+	log    logutils.Log
+	socket string
+	wd     string
+
+	watcher *Watcher
+	lint    LintFunc
+
+	// The runs are sequential.
+	mu      sync.Mutex
+	results map[string]cachedResult
+}
+
+type cachedResult struct {
+	generation uint64
+	issues     []result.Issue
+}
+
+func NewServer(log logutils.Log, socket, wd string, watcher *Watcher, lint LintFunc) *Server {
+	return &Server{
+		log:     log,
+		socket:  socket,
+		wd:      wd,
+		watcher: watcher,
+		lint:    lint,
+		results: map[string]cachedResult{},
+	}
+}
+
+// Run serves the requests until the context is canceled or the configuration file is changed.
+func (s *Server) Run(ctx context.Context) error {
+	if err := s.removeStaleSocket(); err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("unix", s.socket)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.socket, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go s.watcher.Run(ctx)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-s.watcher.ConfigChanged():
+			s.log.Warnf("The configuration file changed: stopping the daemon")
+			cancel()
+		}
+
+		// Removes the socket file.
+		_ = listener.Close()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("failed to accept a connection: %w", err)
+		}
+
+		go s.serve(ctx, conn)
+	}
+}
+
+func (s *Server) serve(ctx context.Context, conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+
+	var resp Response
+
+	var req Request
+	if err := json.NewDecoder(conn).Decode(&req); err != nil {
+		resp.Error = fmt.Sprintf("invalid request: %v", err)
+	} else {
+		resp.Issues, err = s.handle(ctx, &req)
+		if err != nil {
+			resp.Error = err.Error()
+		}
+	}
+
+	if err := json.NewEncoder(conn).Encode(&resp); err != nil {
+		s.log.Warnf("Failed to send the response: %v", err)
+	}
+}
+
+func (s *Server) handle(ctx context.Context, req *Request) ([]result.Issue, error) {
+	if req.WorkingDir != s.wd {
+		return nil, fmt.Errorf("the daemon is running in %s, not in %s", s.wd, req.WorkingDir)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := strings.Join(req.Args, "\x00")
+
+	// Read before the run: the changes during the run are detected by the next request.
+	generation := s.watcher.Generation()
+
+	if cached, ok := s.results[key]; ok && cached.generation == generation {
+		s.log.Infof("No changes: reusing the issues of %v", req.Args)
+		return cached.issues, nil
+	}
+
+	issues, err := s.lint(ctx, req.Args)
+	if err != nil {
+		return nil, err
+	}
+
+	s.results[key] = cachedResult{generation: generation, issues: slices.Clip(issues)}
+
+	return issues, nil
+}
+
+// removeStaleSocket removes the socket file of a daemon that didn't stop properly.
+func (s *Server) removeStaleSocket() error {
+	if _, err := os.Stat(s.socket); err != nil {
+		return nil
+	}
+
+	conn, err := net.Dial("unix", s.socket)
+	if err == nil {
+		_ = conn.Close()
+		return errors.New("a daemon is already running in this directory")
+	}
+
+	return os.Remove(s.socket)
+}
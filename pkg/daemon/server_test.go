@@ -0,0 +1,109 @@
+package daemon
+
+import (
+	"context"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestServer(t *testing.T) {
+	dir := t.TempDir()
+
+	filePath := filepath.Join(dir, "a.go")
+
+	err := os.WriteFile(filePath, []byte("package a\n"), 0o600)
+	require.NoError(t, err)
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+
+	var (
+		changesMu sync.Mutex
+		changes   []string
+	)
+
+	watcher, err := NewWatcher(log, dir, "", func(path string) {
+		changesMu.Lock()
+		defer changesMu.Unlock()
+
+		changes = append(changes, path)
+	})
+	require.NoError(t, err)
+
+	var runs int
+	lint := func(_ context.Context, args []string) ([]result.Issue, error) {
+		runs++
+
+		return []result.Issue{{
+			FromLinter: "linter",
+			Text:       args[0],
+			Pos:        token.Position{Filename: "a.go", Line: runs},
+		}}, nil
+	}
+
+	socket := SocketPath(dir)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	server := NewServer(log, socket, dir, watcher, lint)
+
+	done := make(chan error, 1)
+	go func() { done <- server.Run(ctx) }()
+
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(socket)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	req := &Request{WorkingDir: dir, Args: []string{"./..."}}
+
+	issues, err := Lint(ctx, socket, req)
+	require.NoError(t, err)
+
+	expected := []result.Issue{{FromLinter: "linter", Text: "./...", Pos: token.Position{Filename: "a.go", Line: 1}}}
+	assert.Equal(t, expected, issues)
+
+	// No changes: the issues are reused.
+	issues, err = Lint(ctx, socket, req)
+	require.NoError(t, err)
+
+	assert.Equal(t, expected, issues)
+	assert.Equal(t, 1, runs)
+
+	generation := watcher.Generation()
+
+	err = os.WriteFile(filePath, []byte("package a\n\nvar a = 1\n"), 0o600)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return watcher.Generation() != generation
+	}, 5*time.Second, 10*time.Millisecond)
+
+	changesMu.Lock()
+	assert.Contains(t, changes, filePath)
+	changesMu.Unlock()
+
+	issues, err = Lint(ctx, socket, req)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, runs)
+	assert.Equal(t, 2, issues[0].Line())
+
+	_, err = Lint(ctx, socket, &Request{WorkingDir: "/another", Args: []string{"./..."}})
+	require.Error(t, err)
+
+	cancel()
+	require.NoError(t, <-done)
+
+	_, err = Lint(context.Background(), socket, req)
+	assert.ErrorIs(t, err, ErrNotRunning)
+}
@@ -0,0 +1,154 @@
+package daemon
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+)
+
+// Watcher watches the changes of the Go files, the module files, and the configuration file.
+type Watcher struct {
+	log logutils.Log
+	fsw *fsnotify.Watcher
+
+	configPath string
+
+	// Called with the path of each changed file or new directory, or an empty path if the events have been lost.
+	onChange func(path string)
+
+	// Incremented on each change.
+	generation atomic.Uint64
+
+	configChanged chan struct{}
+}
+
+// NewWatcher watches all the directories of the root, except the hidden ones.
+// The optional onChange function is called on each change (see Run).
+func NewWatcher(log logutils.Log, root, configPath string, onChange func(path string)) (*Watcher, error) {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	if configPath != "" {
+		configPath, err = filepath.Abs(configPath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	w := &Watcher{
+		log:           log,
+		fsw:           fsw,
+		configPath:    configPath,
+		onChange:      onChange,
+		configChanged: make(chan struct{}),
+	}
+
+	if err = w.addDirs(root); err != nil {
+		_ = fsw.Close()
+		return nil, err
+	}
+
+	if configPath != "" && !strings.HasPrefix(configPath, root+string(filepath.Separator)) {
+		if err = fsw.Add(filepath.Dir(configPath)); err != nil {
+			_ = fsw.Close()
+			return nil, err
+		}
+	}
+
+	return w, nil
+}
+
+// Generation changes each time a watched file is changed.
+func (w *Watcher) Generation() uint64 {
+	return w.generation.Load()
+}
+
+// ConfigChanged is closed when the configuration file is changed.
+func (w *Watcher) ConfigChanged() <-chan struct{} {
+	return w.configChanged
+}
+
+// Run handles the file system events until the context is canceled.
+func (w *Watcher) Run(ctx context.Context) {
+	defer func() { _ = w.fsw.Close() }()
+
+	var configChanged bool
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case err := <-w.fsw.Errors:
+			// The events may have been lost.
+			w.log.Warnf("File system watcher: %v", err)
+			w.changed("")
+
+		case event := <-w.fsw.Events:
+			if event.Op&fsnotify.Create != 0 {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					if err := w.addDirs(event.Name); err != nil {
+						w.log.Warnf("Failed to watch %s: %v", event.Name, err)
+					}
+
+					w.changed(event.Name)
+
+					continue
+				}
+			}
+
+			if w.configPath != "" && event.Name == w.configPath && !configChanged {
+				configChanged = true
+				close(w.configChanged)
+			}
+
+			if isWatchedFile(event.Name) {
+				w.changed(event.Name)
+			}
+		}
+	}
+}
+
+func (w *Watcher) changed(path string) {
+	if w.onChange != nil {
+		w.onChange(path)
+	}
+
+	w.generation.Add(1)
+}
+
+func (w *Watcher) addDirs(root string) error {
+	return filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() {
+			return nil
+		}
+
+		if path != root && strings.HasPrefix(d.Name(), ".") {
+			return filepath.SkipDir
+		}
+
+		return w.fsw.Add(path)
+	})
+}
+
+func isWatchedFile(path string) bool {
+	switch filepath.Base(path) {
+	case "go.mod", "go.sum", "go.work", "go.work.sum":
+		return true
+	default:
+		return filepath.Ext(path) == ".go"
+	}
+}
@@ -11,6 +11,14 @@ const (
 	ErrorWasLogged
 )
 
+// Exit codes of the issues per severity (`issues.severity-exit-codes`): the most severe failing issue defines the exit code.
+const (
+	HintIssuesFound = 10 + iota
+	InfoIssuesFound
+	WarningIssuesFound
+	ErrorIssuesFound
+)
+
 type ExitError struct {
 	Message string
 	Code    int
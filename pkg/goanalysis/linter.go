@@ -55,12 +55,12 @@ func NewLinter(name, desc string, analyzers []*analysis.Analyzer, cfg map[string
 	return &Linter{name: name, desc: desc, analyzers: analyzers, cfg: cfg}
 }
 
-func (lnt *Linter) Run(_ context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
+func (lnt *Linter) Run(ctx context.Context, lintCtx *linter.Context) ([]result.Issue, error) {
 	if err := lnt.preRun(lintCtx); err != nil {
 		return nil, err
 	}
 
-	return runAnalyzers(lnt, lintCtx)
+	return runAnalyzers(ctx, lnt, lintCtx)
 }
 
 func (lnt *Linter) UseOriginalPackages() {
@@ -10,6 +10,7 @@
 package goanalysis
 
 import (
+	"context"
 	"encoding/gob"
 	"fmt"
 	"go/token"
@@ -58,19 +59,33 @@ type runner struct {
 	passToPkg      map[*analysis.Pass]*packages.Package
 	passToPkgGuard sync.Mutex
 	sw             *timeutils.Stopwatch
+
+	// The names of the linters using each analyzer: recorded in the facts cache metadata.
+	analyzerLinters map[*analysis.Analyzer][]string
+
+	timingReport *timeutils.Report
+
+	// onDiagnostics receives the diagnostics of each analyzer on each package as soon as the analyzer finishes (`output.stream`).
+	// It's nil if the diagnostics are not streamed.
+	onDiagnostics func(diags []Diagnostic)
+	streamMu      sync.Mutex
+	streamed      map[diagnosticKey]bool
 }
 
 func newRunner(prefix string, logger logutils.Log, pkgCache *pkgcache.Cache, loadGuard *load.Guard,
-	loadMode LoadMode, sw *timeutils.Stopwatch,
+	loadMode LoadMode, sw *timeutils.Stopwatch, analyzerLinters map[*analysis.Analyzer][]string,
+	timingReport *timeutils.Report,
 ) *runner {
 	return &runner{
-		prefix:    prefix,
-		log:       logger,
-		pkgCache:  pkgCache,
-		loadGuard: loadGuard,
-		loadMode:  loadMode,
-		passToPkg: map[*analysis.Pass]*packages.Package{},
-		sw:        sw,
+		prefix:          prefix,
+		log:             logger,
+		pkgCache:        pkgCache,
+		loadGuard:       loadGuard,
+		loadMode:        loadMode,
+		passToPkg:       map[*analysis.Pass]*packages.Package{},
+		sw:              sw,
+		analyzerLinters: analyzerLinters,
+		timingReport:    timingReport,
 	}
 }
 
@@ -80,13 +95,13 @@ func newRunner(prefix string, logger logutils.Log, pkgCache *pkgcache.Cache, loa
 // It provides most of the logic for the main functions of both the
 // singlechecker and the multi-analysis commands.
 // It returns the appropriate exit code.
-func (r *runner) run(analyzers []*analysis.Analyzer, initialPackages []*packages.Package) ([]Diagnostic,
+func (r *runner) run(ctx context.Context, analyzers []*analysis.Analyzer, initialPackages []*packages.Package) ([]Diagnostic,
 	[]error, map[*analysis.Pass]*packages.Package,
 ) {
 	debugf("Analyzing %d packages on load mode %s", len(initialPackages), r.loadMode)
 	defer r.pkgCache.Trim()
 
-	roots := r.analyze(initialPackages, analyzers)
+	roots := r.analyze(ctx, initialPackages, analyzers)
 
 	diags, errs := extractDiagnostics(roots)
 
@@ -221,7 +236,7 @@ func (r *runner) prepareAnalysis(pkgs []*packages.Package,
 	return initialPkgs, allActions, roots
 }
 
-func (r *runner) analyze(pkgs []*packages.Package, analyzers []*analysis.Analyzer) []*action {
+func (r *runner) analyze(ctx context.Context, pkgs []*packages.Package, analyzers []*analysis.Analyzer) []*action {
 	initialPkgs, actions, rootActions := r.prepareAnalysis(pkgs, analyzers)
 
 	actionPerPkg := map[*packages.Package][]*action{}
@@ -253,6 +268,8 @@ func (r *runner) analyze(pkgs []*packages.Package, analyzers []*analysis.Analyze
 			actions:    actionPerPkg[pkg],
 			loadGuard:  r.loadGuard,
 			dependents: 1, // self dependent
+
+			timingReport: r.timingReport,
 		}
 	}
 	for _, act := range actions {
@@ -270,7 +287,7 @@ func (r *runner) analyze(pkgs []*packages.Package, analyzers []*analysis.Analyze
 		if lp.isInitial {
 			wg.Add(1)
 			go func(lp *loadingPackage) {
-				lp.analyzeRecursive(r.loadMode, loadSem)
+				lp.analyzeRecursive(ctx, r.loadMode, loadSem)
 				wg.Done()
 			}(lp)
 		}
@@ -280,6 +297,52 @@ func (r *runner) analyze(pkgs []*packages.Package, analyzers []*analysis.Analyze
 	return rootActions
 }
 
+// diagnosticKey de-duplicates the diagnostics by position (not token.Pos) to
+// avoid double-reporting in source files that belong to
+// multiple packages, such as foo and foo.test.
+type diagnosticKey struct {
+	token.Position
+	*analysis.Analyzer
+	message string
+}
+
+// streamDiagnostics sends the diagnostics of a root action to onDiagnostics (`output.stream`).
+// The duplicated diagnostics are ignored, like in extractDiagnostics.
+func (r *runner) streamDiagnostics(act *action) {
+	if r.onDiagnostics == nil || !act.isroot || act.err != nil || len(act.diagnostics) == 0 {
+		return
+	}
+
+	r.streamMu.Lock()
+	defer r.streamMu.Unlock()
+
+	if r.streamed == nil {
+		r.streamed = map[diagnosticKey]bool{}
+	}
+
+	var diags []Diagnostic
+
+	for _, diag := range act.diagnostics {
+		posn := act.pkg.Fset.Position(diag.Pos)
+		k := diagnosticKey{posn, act.a, diag.Message}
+		if r.streamed[k] {
+			continue // duplicate
+		}
+		r.streamed[k] = true
+
+		diags = append(diags, Diagnostic{
+			Diagnostic: diag,
+			Analyzer:   act.a,
+			Position:   posn,
+			Pkg:        act.pkg,
+		})
+	}
+
+	if len(diags) != 0 {
+		r.onDiagnostics(diags)
+	}
+}
+
 func extractDiagnostics(roots []*action) (retDiags []Diagnostic, retErrors []error) {
 	extracted := make(map[*action]bool)
 	var extract func(*action)
@@ -294,15 +357,7 @@ func extractDiagnostics(roots []*action) (retDiags []Diagnostic, retErrors []err
 		}
 	}
 
-	// De-duplicate diagnostics by position (not token.Pos) to
-	// avoid double-reporting in source files that belong to
-	// multiple packages, such as foo and foo.test.
-	type key struct {
-		token.Position
-		*analysis.Analyzer
-		message string
-	}
-	seen := make(map[key]bool)
+	seen := make(map[diagnosticKey]bool)
 
 	extract = func(act *action) {
 		if act.err != nil {
@@ -319,7 +374,7 @@ func extractDiagnostics(roots []*action) (retDiags []Diagnostic, retErrors []err
 				// as most users don't care.
 
 				posn := act.pkg.Fset.Position(diag.Pos)
-				k := key{posn, act.a, diag.Message}
+				k := diagnosticKey{posn, act.a, diag.Message}
 				if seen[k] {
 					continue // duplicate
 				}
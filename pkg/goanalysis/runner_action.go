@@ -1,6 +1,7 @@
 package goanalysis
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"go/types"
@@ -16,6 +17,7 @@ import (
 	"github.com/golangci/golangci-lint/internal/errorutil"
 	"github.com/golangci/golangci-lint/internal/pkgcache"
 	"github.com/golangci/golangci-lint/pkg/goanalysis/pkgerrors"
+	"github.com/golangci/golangci-lint/pkg/tracing"
 )
 
 type actionAllocator struct {
@@ -95,7 +97,7 @@ func (act *action) waitUntilDependingAnalyzersWorked() {
 	}
 }
 
-func (act *action) analyzeSafe() {
+func (act *action) analyzeSafe(ctx context.Context) {
 	defer func() {
 		if p := recover(); p != nil {
 			if !act.isroot {
@@ -110,7 +112,14 @@ func (act *action) analyzeSafe() {
 		}
 	}()
 	act.r.sw.TrackStage(act.a.Name, func() {
-		act.analyze()
+		if !act.needAnalyzeSource {
+			act.analyze()
+			return
+		}
+
+		tracing.Track(ctx, act.String(), func(_ context.Context) {
+			act.r.timingReport.TrackAction(act.a.Name, act.pkg.PkgPath, act.r.analyzerLinters[act.a], act.analyze)
+		}, tracing.Attr("analyzer", act.a.Name), tracing.Attr("package", act.pkg.PkgPath))
 	})
 }
 
@@ -328,13 +337,17 @@ func (act *action) persistFactsToCache() error {
 	factsCacheDebugf("Caching %d facts for package %q and analyzer %s", len(facts), act.pkg.Name, act.a.Name)
 
 	key := fmt.Sprintf("%s/facts", analyzer.Name)
-	return act.r.pkgCache.Put(act.pkg, pkgcache.HashModeNeedAllDeps, key, facts)
+	meta := pkgcache.Meta{Kind: pkgcache.KindFacts, Linters: act.r.analyzerLinters[analyzer]}
+	return act.r.pkgCache.Put(act.pkg, pkgcache.HashModeNeedAllDeps, key, facts, meta)
 }
 
 func (act *action) loadPersistedFacts() bool {
 	var facts []Fact
 	key := fmt.Sprintf("%s/facts", act.a.Name)
-	if err := act.r.pkgCache.Get(act.pkg, pkgcache.HashModeNeedAllDeps, key, &facts); err != nil {
+	err := act.r.pkgCache.Get(act.pkg, pkgcache.HashModeNeedAllDeps, key, &facts)
+	act.r.pkgCache.CountLoad(pkgcache.KindFacts, err == nil)
+	act.r.timingReport.CountCacheLoad(act.pkg.PkgPath, string(pkgcache.KindFacts), err == nil)
+	if err != nil {
 		if !errors.Is(err, pkgcache.ErrMissing) && !errors.Is(err, io.EOF) {
 			act.r.log.Warnf("Failed to get persisted facts: %s", err)
 		}
@@ -1,6 +1,7 @@
 package goanalysis
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"go/ast"
@@ -18,6 +19,8 @@ import (
 	"github.com/golangci/golangci-lint/pkg/goanalysis/load"
 	"github.com/golangci/golangci-lint/pkg/goutil"
 	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/timeutils"
+	"github.com/golangci/golangci-lint/pkg/tracing"
 )
 
 const unsafePkgName = "unsafe"
@@ -32,25 +35,27 @@ type loadingPackage struct {
 	dependents  int32 // number of depending on it packages
 	analyzeOnce sync.Once
 	decUseMutex sync.Mutex
+
+	timingReport *timeutils.Report
 }
 
-func (lp *loadingPackage) analyzeRecursive(loadMode LoadMode, loadSem chan struct{}) {
+func (lp *loadingPackage) analyzeRecursive(ctx context.Context, loadMode LoadMode, loadSem chan struct{}) {
 	lp.analyzeOnce.Do(func() {
 		// Load the direct dependencies, in parallel.
 		var wg sync.WaitGroup
 		wg.Add(len(lp.imports))
 		for _, imp := range lp.imports {
 			go func(imp *loadingPackage) {
-				imp.analyzeRecursive(loadMode, loadSem)
+				imp.analyzeRecursive(ctx, loadMode, loadSem)
 				wg.Done()
 			}(imp)
 		}
 		wg.Wait()
-		lp.analyze(loadMode, loadSem)
+		lp.analyze(ctx, loadMode, loadSem)
 	})
 }
 
-func (lp *loadingPackage) analyze(loadMode LoadMode, loadSem chan struct{}) {
+func (lp *loadingPackage) analyze(ctx context.Context, loadMode LoadMode, loadSem chan struct{}) {
 	loadSem <- struct{}{}
 	defer func() {
 		<-loadSem
@@ -59,7 +64,13 @@ func (lp *loadingPackage) analyze(loadMode LoadMode, loadSem chan struct{}) {
 	// Save memory on unused more fields.
 	defer lp.decUse(loadMode < LoadModeWholeProgram)
 
-	if err := lp.loadWithFacts(loadMode); err != nil {
+	var err error
+	tracing.Track(ctx, "load "+lp.pkg.String(), func(_ context.Context) {
+		lp.timingReport.TrackPackageLoad(lp.pkg.PkgPath, func() {
+			err = lp.loadWithFacts(loadMode)
+		})
+	}, tracing.Attr("package", lp.pkg.PkgPath))
+	if err != nil {
 		werr := fmt.Errorf("failed to load package %s: %w", lp.pkg.Name, err)
 		// Don't need to write error to errCh, it will be extracted and reported on another layer.
 		// Unblock depending on actions and propagate error.
@@ -78,7 +89,9 @@ func (lp *loadingPackage) analyze(loadMode LoadMode, loadSem chan struct{}) {
 
 			act.waitUntilDependingAnalyzersWorked()
 
-			act.analyzeSafe()
+			act.analyzeSafe(ctx)
+
+			act.r.streamDiagnostics(act)
 		}(act)
 	}
 	actsWg.Wait()
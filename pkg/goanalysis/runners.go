@@ -1,8 +1,11 @@
 package goanalysis
 
 import (
+	"context"
 	"fmt"
+	"go/token"
 	"runtime"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -29,14 +32,17 @@ type runAnalyzersConfig interface {
 	getLoadMode() LoadMode
 }
 
-func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Issue, error) {
+func runAnalyzers(ctx context.Context, cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Issue, error) {
 	log := lintCtx.Log.Child(logutils.DebugKeyGoAnalysis)
 	sw := timeutils.NewStopwatch("analyzers", log)
 
 	const stagesToPrint = 10
 	defer sw.PrintTopStages(stagesToPrint)
 
-	runner := newRunner(cfg.getName(), log, lintCtx.PkgCache, lintCtx.LoadGuard, cfg.getLoadMode(), sw)
+	analyzerLinters := getAnalyzerLinters(cfg)
+
+	runner := newRunner(cfg.getName(), log, lintCtx.PkgCache, lintCtx.LoadGuard, cfg.getLoadMode(), sw,
+		analyzerLinters, lintCtx.TimingReport)
 
 	pkgs := lintCtx.Packages
 	if cfg.useOriginalPackages() {
@@ -44,6 +50,21 @@ func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Iss
 	}
 
 	issues, pkgsFromCache := loadIssuesFromCache(pkgs, lintCtx, cfg.getAnalyzers())
+
+	// The issues from the cache and the diagnostics are streamed as soon as they are available,
+	// the other issues (e.g. the issues reported at the end of the analysis) are returned.
+	// The diagnostics are streamed before the result of the linter is known:
+	// the issues streamed by a linter failing later are kept, unlike the issues of a failed linter without streaming.
+	if lintCtx.IssuesStream != nil {
+		if len(issues) != 0 {
+			lintCtx.IssuesStream(issues)
+		}
+
+		runner.onDiagnostics = func(diags []Diagnostic) {
+			lintCtx.IssuesStream(buildIssues(diags, cfg.getLinterNameForDiagnostic))
+		}
+	}
+
 	var pkgsToAnalyze []*packages.Package
 	for _, pkg := range pkgs {
 		if !pkgsFromCache[pkg] {
@@ -51,17 +72,17 @@ func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Iss
 		}
 	}
 
-	diags, errs, passToPkg := runner.run(cfg.getAnalyzers(), pkgsToAnalyze)
+	diags, errs, passToPkg := runner.run(ctx, cfg.getAnalyzers(), pkgsToAnalyze)
 
 	defer func() {
 		if len(errs) == 0 {
 			// If we try to save to cache even if we have compilation errors
 			// we won't see them on repeated runs.
-			saveIssuesToCache(pkgs, pkgsFromCache, issues, lintCtx, cfg.getAnalyzers())
+			saveIssuesToCache(pkgs, pkgsFromCache, issues, lintCtx, cfg.getAnalyzers(), getLinterNames(analyzerLinters))
 		}
 	}()
 
-	buildAllIssues := func() []result.Issue {
+	buildReportedIssues := func() []result.Issue {
 		var retIssues []result.Issue
 		reportedIssues := cfg.reportIssues(lintCtx)
 		for i := range reportedIssues {
@@ -71,7 +92,6 @@ func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Iss
 			}
 			retIssues = append(retIssues, *issue)
 		}
-		retIssues = append(retIssues, buildIssues(diags, cfg.getLinterNameForDiagnostic)...)
 		return retIssues
 	}
 
@@ -80,8 +100,16 @@ func runAnalyzers(cfg runAnalyzersConfig, lintCtx *linter.Context) ([]result.Iss
 		return nil, err
 	}
 
+	reportedIssues := buildReportedIssues()
+
 	issues = append(issues, errIssues...)
-	issues = append(issues, buildAllIssues()...)
+	issues = append(issues, reportedIssues...)
+	issues = append(issues, buildIssues(diags, cfg.getLinterNameForDiagnostic)...)
+
+	if lintCtx.IssuesStream != nil {
+		// The issues from the cache and the diagnostics are already streamed.
+		return append(errIssues, reportedIssues...), nil
+	}
 
 	return issues, nil
 }
@@ -100,10 +128,11 @@ func buildIssues(diags []Diagnostic, linterNameBuilder func(diag *Diagnostic) st
 		}
 
 		issues = append(issues, result.Issue{
-			FromLinter: linterName,
-			Text:       text,
-			Pos:        diag.Position,
-			Pkg:        diag.Pkg,
+			FromLinter:  linterName,
+			Text:        text,
+			Pos:         diag.Position,
+			Pkg:         diag.Pkg,
+			Replacement: buildReplacement(diag),
 		})
 
 		if len(diag.Related) > 0 {
@@ -120,12 +149,104 @@ func buildIssues(diags []Diagnostic, linterNameBuilder func(diag *Diagnostic) st
 	return issues
 }
 
+// buildReplacement converts the first suggested fix of the diagnostic applicable to the diagnostic's file
+// into byte-offset based text edits.
+func buildReplacement(diag *Diagnostic) *result.Replacement {
+	if len(diag.SuggestedFixes) == 0 || diag.Pkg == nil || diag.Pkg.Fset == nil {
+		return nil
+	}
+
+	fset := diag.Pkg.Fset
+
+	// The offsets are relative to the file on the disk: fixes can't be applied if the position is adjusted by line directives.
+	if fset.PositionFor(diag.Pos, false) != diag.Position {
+		return nil
+	}
+
+	file := fset.File(diag.Pos)
+	if file == nil {
+		return nil
+	}
+
+	for _, fix := range diag.SuggestedFixes {
+		if edits := buildTextEdits(file, fix.TextEdits); len(edits) != 0 {
+			return &result.Replacement{TextEdits: edits}
+		}
+	}
+
+	return nil
+}
+
+// buildTextEdits returns nil if at least one of the edits is outside the file.
+func buildTextEdits(file *token.File, textEdits []analysis.TextEdit) []result.TextEdit {
+	inFile := func(pos token.Pos) bool {
+		return pos.IsValid() && int(pos) >= file.Base() && int(pos) <= file.Base()+file.Size()
+	}
+
+	edits := make([]result.TextEdit, 0, len(textEdits))
+	for _, edit := range textEdits {
+		end := edit.End
+		if !end.IsValid() {
+			end = edit.Pos // insertion
+		}
+
+		if !inFile(edit.Pos) || !inFile(end) || end < edit.Pos {
+			return nil
+		}
+
+		edits = append(edits, result.TextEdit{
+			Pos:     file.Offset(edit.Pos),
+			End:     file.Offset(end),
+			NewText: string(edit.NewText),
+		})
+	}
+
+	return edits
+}
+
+// getAnalyzerLinters returns the names of the linters using each analyzer (directly or as a dependency).
+func getAnalyzerLinters(cfg runAnalyzersConfig) map[*analysis.Analyzer][]string {
+	analyzerLinters := map[*analysis.Analyzer][]string{}
+
+	var visit func(a *analysis.Analyzer, linterName string)
+	visit = func(a *analysis.Analyzer, linterName string) {
+		if slices.Contains(analyzerLinters[a], linterName) {
+			return
+		}
+
+		analyzerLinters[a] = append(analyzerLinters[a], linterName)
+
+		for _, req := range a.Requires {
+			visit(req, linterName)
+		}
+	}
+
+	for _, a := range cfg.getAnalyzers() {
+		visit(a, cfg.getLinterNameForDiagnostic(&Diagnostic{Analyzer: a}))
+	}
+
+	return analyzerLinters
+}
+
+func getLinterNames(analyzerLinters map[*analysis.Analyzer][]string) []string {
+	var names []string
+	for _, linterNames := range analyzerLinters {
+		for _, name := range linterNames {
+			if !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+
+	return names
+}
+
 func getIssuesCacheKey(analyzers []*analysis.Analyzer) string {
 	return "lint/result:" + analyzersHashID(analyzers)
 }
 
 func saveIssuesToCache(allPkgs []*packages.Package, pkgsFromCache map[*packages.Package]bool,
-	issues []result.Issue, lintCtx *linter.Context, analyzers []*analysis.Analyzer,
+	issues []result.Issue, lintCtx *linter.Context, analyzers []*analysis.Analyzer, linterNames []string,
 ) {
 	startedAt := time.Now()
 	perPkgIssues := map[*packages.Package][]result.Issue{}
@@ -163,7 +284,8 @@ func saveIssuesToCache(allPkgs []*packages.Package, pkgsFromCache map[*packages.
 				}
 
 				atomic.AddInt64(&savedIssuesCount, int64(len(encodedIssues)))
-				if err := lintCtx.PkgCache.Put(pkg, pkgcache.HashModeNeedAllDeps, lintResKey, encodedIssues); err != nil {
+				meta := pkgcache.Meta{Kind: pkgcache.KindIssues, Linters: linterNames}
+				if err := lintCtx.PkgCache.Put(pkg, pkgcache.HashModeNeedAllDeps, lintResKey, encodedIssues, meta); err != nil {
 					lintCtx.Log.Infof("Failed to save package %s issues (%d) to cache: %s", pkg, len(pkgIssues), err)
 				} else {
 					issuesCacheDebugf("Saved package %s issues (%d) to cache", pkg, len(pkgIssues))
@@ -211,6 +333,8 @@ func loadIssuesFromCache(pkgs []*packages.Package, lintCtx *linter.Context,
 			for pkg := range pkgCh {
 				var pkgIssues []EncodingIssue
 				err := lintCtx.PkgCache.Get(pkg, pkgcache.HashModeNeedAllDeps, lintResKey, &pkgIssues)
+				lintCtx.PkgCache.CountLoad(pkgcache.KindIssues, err == nil)
+				lintCtx.TimingReport.CountCacheLoad(pkg.PkgPath, string(pkgcache.KindIssues), err == nil)
 				cacheRes := pkgToCacheRes[pkg]
 				cacheRes.loadErr = err
 				if err != nil {
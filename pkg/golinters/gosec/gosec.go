@@ -131,7 +131,7 @@ func runGoSec(lintCtx *linter.Context, pass *analysis.Pass, settings *config.GoS
 		}
 
 		issues = append(issues, goanalysis.NewIssue(&result.Issue{
-			Severity: convertScoreToString(i.Severity),
+			Severity: convertToSeverity(i.Severity, i.Confidence),
 			Pos: token.Position{
 				Filename: i.File,
 				Line:     line,
@@ -163,17 +163,27 @@ func toGosecConfig(settings *config.GoSecSettings) gosec.Config {
 	return conf
 }
 
-func convertScoreToString(score issue.Score) string {
-	switch score {
+// convertToSeverity converts the gosec severity into a normalized severity:
+// the severity is lowered by one level when the confidence is low.
+func convertToSeverity(severity, confidence issue.Score) string {
+	var level int
+
+	switch severity {
 	case issue.Low:
-		return "low"
+		level = config.SeverityLevel(config.SeverityInfo)
 	case issue.Medium:
-		return "medium"
+		level = config.SeverityLevel(config.SeverityWarning)
 	case issue.High:
-		return "high"
+		level = config.SeverityLevel(config.SeverityError)
 	default:
 		return ""
 	}
+
+	if confidence == issue.Low && level > 0 {
+		level--
+	}
+
+	return config.SeverityLevels[level]
 }
 
 // based on https://github.com/securego/gosec/blob/47bfd4eb6fc7395940933388550b547538b4c946/config.go#L52-L62
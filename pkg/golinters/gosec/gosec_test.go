@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/securego/gosec/v2"
+	"github.com/securego/gosec/v2/issue"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/golangci/golangci-lint/pkg/config"
@@ -67,3 +68,57 @@ func Test_toGosecConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_convertToSeverity(t *testing.T) {
+	testCases := []struct {
+		desc       string
+		severity   issue.Score
+		confidence issue.Score
+		expected   string
+	}{
+		{
+			desc:       "high severity",
+			severity:   issue.High,
+			confidence: issue.High,
+			expected:   "error",
+		},
+		{
+			desc:       "medium severity",
+			severity:   issue.Medium,
+			confidence: issue.Medium,
+			expected:   "warning",
+		},
+		{
+			desc:       "low severity",
+			severity:   issue.Low,
+			confidence: issue.High,
+			expected:   "info",
+		},
+		{
+			desc:       "high severity with low confidence",
+			severity:   issue.High,
+			confidence: issue.Low,
+			expected:   "warning",
+		},
+		{
+			desc:       "low severity with low confidence",
+			severity:   issue.Low,
+			confidence: issue.Low,
+			expected:   "hint",
+		},
+		{
+			desc:       "unknown severity",
+			severity:   issue.Score(42),
+			confidence: issue.High,
+			expected:   "",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, convertToSeverity(test.severity, test.confidence))
+		})
+	}
+}
@@ -57,6 +57,10 @@ type Config struct {
 	IsSlow          bool
 	DoesChangeTypes bool
 
+	// Severity is the default severity of the issues (error, warning, info, hint):
+	// used when the linter doesn't provide a severity.
+	Severity string
+
 	Since       string
 	Deprecation *Deprecation
 }
@@ -117,6 +121,11 @@ func (lc *Config) WithChangeTypes() *Config {
 	return lc
 }
 
+func (lc *Config) WithSeverity(severity string) *Config {
+	lc.Severity = severity
+	return lc
+}
+
 func (lc *Config) WithSince(version string) *Config {
 	lc.Since = version
 	return lc
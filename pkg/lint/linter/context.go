@@ -10,6 +10,8 @@ import (
 	"github.com/golangci/golangci-lint/pkg/fsutils"
 	"github.com/golangci/golangci-lint/pkg/goanalysis/load"
 	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/timeutils"
 )
 
 type Context struct {
@@ -26,6 +28,14 @@ type Context struct {
 
 	PkgCache  *pkgcache.Cache
 	LoadGuard *load.Guard
+
+	// TimingReport is nil if the timing report is disabled (`--timing-report`).
+	TimingReport *timeutils.Report
+
+	// IssuesStream receives the issues as soon as they are found, while the linter is running (`output.stream`).
+	// It's nil if the issues are not streamed.
+	// The issues sent to the stream are not returned by the linter.
+	IssuesStream func(issues []result.Issue)
 }
 
 func (c *Context) Settings() *config.LintersSettings {
@@ -312,6 +312,7 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithSince("v1.30.0").
 			WithPresets(linter.PresetFormatting, linter.PresetImport).
 			WithAutoFix().
+			WithSeverity(config.SeverityInfo).
 			WithURL("https://github.com/daixiang0/gci"),
 
 		linter.NewConfig(ginkgolinter.New(&cfg.LintersSettings.GinkgoLinter)).
@@ -385,12 +386,14 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithSince("v1.0.0").
 			WithPresets(linter.PresetFormatting).
 			WithAutoFix().
+			WithSeverity(config.SeverityInfo).
 			WithURL("https://pkg.go.dev/cmd/gofmt"),
 
 		linter.NewConfig(gofumpt.New(&cfg.LintersSettings.Gofumpt)).
 			WithSince("v1.28.0").
 			WithPresets(linter.PresetFormatting).
 			WithAutoFix().
+			WithSeverity(config.SeverityInfo).
 			WithURL("https://github.com/mvdan/gofumpt"),
 
 		linter.NewConfig(goheader.New(&cfg.LintersSettings.Goheader)).
@@ -403,6 +406,7 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithSince("v1.20.0").
 			WithPresets(linter.PresetFormatting, linter.PresetImport).
 			WithAutoFix().
+			WithSeverity(config.SeverityInfo).
 			WithURL("https://pkg.go.dev/golang.org/x/tools/cmd/goimports"),
 
 		linter.NewConfig(linter.NewNoopDeprecated("golint", cfg, linter.DeprecationError)).
@@ -451,6 +455,7 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithLoadForGoAnalysis().
 			WithPresets(linter.PresetStyle).
 			WithAlternativeNames(megacheckName).
+			WithSeverity(config.SeverityInfo).
 			WithURL("https://github.com/dominikh/go-tools/tree/master/simple"),
 
 		linter.NewConfig(gosmopolitan.New(&cfg.LintersSettings.Gosmopolitan)).
@@ -465,6 +470,7 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithLoadForGoAnalysis().
 			WithPresets(linter.PresetBugs, linter.PresetMetaLinter).
 			WithAlternativeNames("vet", "vetshadow").
+			WithSeverity(config.SeverityWarning).
 			WithURL("https://pkg.go.dev/cmd/vet"),
 
 		linter.NewConfig(grouper.New(&cfg.LintersSettings.Grouper)).
@@ -706,6 +712,7 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithLoadForGoAnalysis().
 			WithPresets(linter.PresetBugs, linter.PresetMetaLinter).
 			WithAlternativeNames(megacheckName).
+			WithSeverity(config.SeverityError).
 			WithURL("https://staticcheck.io/"),
 
 		linter.NewConfig(linter.NewNoopDeprecated("structcheck", cfg, linter.DeprecationError)).
@@ -719,6 +726,7 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 			WithSince("v1.20.0").
 			WithLoadForGoAnalysis().
 			WithPresets(linter.PresetStyle).
+			WithSeverity(config.SeverityInfo).
 			WithURL("https://github.com/dominikh/go-tools/tree/master/stylecheck"),
 
 		linter.NewConfig(tagalign.New(&cfg.LintersSettings.TagAlign)).
@@ -769,6 +777,7 @@ func (LinterBuilder) Build(cfg *config.Config) ([]*linter.Config, error) {
 		linter.NewConfig(golinters.NewTypecheck()).
 			WithInternal().
 			WithEnabledByDefault().
+			WithSeverity(config.SeverityError).
 			WithSince("v1.3.0"),
 
 		linter.NewConfig(unconvert.New(&cfg.LintersSettings.Unconvert)).
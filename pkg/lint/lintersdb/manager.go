@@ -26,6 +26,8 @@ type Manager struct {
 
 	cfg *config.Config
 
+	builders []Builder
+
 	linters []*linter.Config
 
 	nameToLCs map[string][]*linter.Config
@@ -37,6 +39,7 @@ func NewManager(log logutils.Log, cfg *config.Config, builders ...Builder) (*Man
 	m := &Manager{
 		log:       log,
 		debugf:    logutils.Debug(logutils.DebugKeyEnabledLinters),
+		builders:  builders,
 		nameToLCs: make(map[string][]*linter.Config),
 	}
 
@@ -68,6 +71,11 @@ func NewManager(log logutils.Log, cfg *config.Config, builders ...Builder) (*Man
 	return m, nil
 }
 
+// ForConfig creates a new Manager for another configuration (e.g. a nested configuration), with the same builders.
+func (m *Manager) ForConfig(cfg *config.Config) (*Manager, error) {
+	return NewManager(m.log, cfg, m.builders...)
+}
+
 func (m *Manager) GetLinterConfigs(name string) []*linter.Config {
 	return m.nameToLCs[name]
 }
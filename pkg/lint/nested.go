@@ -0,0 +1,114 @@
+package lint
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
+	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
+)
+
+// Scope is the directory of a nested configuration (`run.nested-configs`):
+// the packages inside the directory are analyzed by the linters of this configuration.
+type Scope struct {
+	Cfg       *config.Config
+	DBManager *lintersdb.Manager
+	Linters   []*linter.Config
+}
+
+// NewScopes creates the scopes of the nested configurations, in the order of config.Config.GetNestedConfigs.
+func NewScopes(cfg *config.Config, dbManager *lintersdb.Manager) ([]*Scope, error) {
+	var scopes []*Scope
+
+	for _, nestedCfg := range cfg.GetNestedConfigs() {
+		nestedManager, err := dbManager.ForConfig(nestedCfg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid configuration %s: %w", nestedCfg.GetConfigPath(), err)
+		}
+
+		linters, err := nestedManager.GetOptimizedLinters()
+		if err != nil {
+			return nil, err
+		}
+
+		scopes = append(scopes, &Scope{Cfg: nestedCfg, DBManager: nestedManager, Linters: linters})
+	}
+
+	return scopes, nil
+}
+
+// LintersToLoad returns the linters of the root configuration and of the scopes:
+// the packages are loaded once for all the linters.
+func LintersToLoad(linters []*linter.Config, scopes []*Scope) []*linter.Config {
+	if len(scopes) == 0 {
+		return linters
+	}
+
+	all := append([]*linter.Config{}, linters...)
+	for _, scope := range scopes {
+		all = append(all, scope.Linters...)
+	}
+
+	return all
+}
+
+// scopeRun is a set of linters and the context of the packages they analyze.
+type scopeRun struct {
+	lintCtx *linter.Context
+	linters []*linter.Config
+}
+
+// splitContext splits the packages of the context by nearest configuration:
+// returns the context of the root configuration and the context of each scope.
+func splitContext(lintCtx *linter.Context, scopes []*Scope) (*linter.Context, []*linter.Context) {
+	if len(scopes) == 0 {
+		return lintCtx, nil
+	}
+
+	rootCtx := *lintCtx
+	rootCtx.Packages, rootCtx.OriginalPackages = nil, nil
+
+	scopeCtxs := make([]*linter.Context, len(scopes))
+	for i, scope := range scopes {
+		scopeCtx := *lintCtx
+		scopeCtx.Cfg = scope.Cfg
+		scopeCtx.Packages, scopeCtx.OriginalPackages = nil, nil
+
+		scopeCtxs[i] = &scopeCtx
+	}
+
+	nearest := func(pkg *packages.Package) *linter.Context {
+		index := lintCtx.Cfg.NearestNestedConfig(packageDir(pkg))
+		if index == -1 || index >= len(scopeCtxs) {
+			return &rootCtx
+		}
+
+		return scopeCtxs[index]
+	}
+
+	for _, pkg := range lintCtx.Packages {
+		c := nearest(pkg)
+		c.Packages = append(c.Packages, pkg)
+	}
+
+	for _, pkg := range lintCtx.OriginalPackages {
+		c := nearest(pkg)
+		c.OriginalPackages = append(c.OriginalPackages, pkg)
+	}
+
+	return &rootCtx, scopeCtxs
+}
+
+// packageDir returns the directory of the files of the package.
+func packageDir(pkg *packages.Package) string {
+	for _, files := range [][]string{pkg.GoFiles, pkg.CompiledGoFiles, pkg.OtherFiles, pkg.IgnoredFiles} {
+		if len(files) != 0 {
+			return filepath.Dir(files[0])
+		}
+	}
+
+	return ""
+}
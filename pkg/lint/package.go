@@ -35,6 +35,9 @@ type PackageLoader struct {
 	goenv *goutil.Env
 
 	loadGuard *load.Guard
+
+	// The packages kept between the loads (long-lived processes), nil if the packages are not reused.
+	loaded *LoadedPackages
 }
 
 // NewPackageLoader creates a new PackageLoader.
@@ -54,7 +57,11 @@ func NewPackageLoader(log logutils.Log, cfg *config.Config, args []string, goenv
 func (l *PackageLoader) Load(ctx context.Context, linters []*linter.Config) (pkgs, deduplicatedPkgs []*packages.Package, err error) {
 	loadMode := findLoadMode(linters)
 
-	pkgs, err = l.loadPackages(ctx, loadMode)
+	if l.loaded != nil {
+		pkgs, err = l.loadOrReuse(ctx, loadMode)
+	} else {
+		pkgs, err = l.loadPackages(ctx, loadMode, buildArgs(l.args))
+	}
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to load packages: %w", err)
 	}
@@ -62,7 +69,7 @@ func (l *PackageLoader) Load(ctx context.Context, linters []*linter.Config) (pkg
 	return pkgs, l.filterDuplicatePackages(pkgs), nil
 }
 
-func (l *PackageLoader) loadPackages(ctx context.Context, loadMode packages.LoadMode) ([]*packages.Package, error) {
+func (l *PackageLoader) loadPackages(ctx context.Context, loadMode packages.LoadMode, args []string) ([]*packages.Package, error) {
 	defer func(startedAt time.Time) {
 		l.log.Infof("Go packages loading at mode %s took %s", stringifyLoadMode(loadMode), time.Since(startedAt))
 	}(time.Now())
@@ -78,8 +85,6 @@ func (l *PackageLoader) loadPackages(ctx context.Context, loadMode packages.Load
 		// TODO: use fset, parsefile, overlay
 	}
 
-	args := buildArgs(l.args)
-
 	l.debugf("Built loader args are %s", args)
 
 	pkgs, err := packages.Load(conf, args...)
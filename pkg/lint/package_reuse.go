@@ -0,0 +1,326 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"golang.org/x/tools/go/packages"
+
+	"github.com/golangci/golangci-lint/pkg/goanalysis/load"
+)
+
+// LoadedPackages are the packages kept in memory between the loads of a long-lived process (lsp, daemon):
+// only the packages of the changed files are loaded again.
+//
+// The packages are only reused when their syntax and their types are loaded by the linters (go/analysis):
+// the fields set by the linters are cleared before each reuse.
+type LoadedPackages struct {
+	// Protects the changes: the files are invalidated during the loads.
+	mu sync.Mutex
+	// The changed files since the last load (absolute paths).
+	changes map[string]bool
+	// True when all the packages must be loaded again.
+	changedAll bool
+
+	mode  packages.LoadMode
+	roots []*packages.Package
+
+	// The fields set by the loader, restored before each reuse.
+	states map[*packages.Package]packageState
+}
+
+type packageState struct {
+	errors     []packages.Error
+	typesSizes types.Sizes
+}
+
+func NewLoadedPackages() *LoadedPackages {
+	return &LoadedPackages{}
+}
+
+// Invalidate marks a file as changed: its packages are loaded again by the next load.
+// An empty path, or a path other than a Go file (e.g. go.mod, a new directory), invalidates all the packages.
+func (lp *LoadedPackages) Invalidate(path string) {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+
+	if path == "" || filepath.Ext(path) != ".go" {
+		lp.changedAll = true
+		return
+	}
+
+	if lp.changes == nil {
+		lp.changes = map[string]bool{}
+	}
+
+	lp.changes[path] = true
+}
+
+func (lp *LoadedPackages) takeChanges() (changes []string, all bool) {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+
+	for path := range lp.changes {
+		changes = append(changes, path)
+	}
+
+	slices.Sort(changes)
+
+	all = lp.changedAll
+
+	lp.changes = nil
+	lp.changedAll = false
+
+	return changes, all
+}
+
+// ReusePackages keeps the loaded packages for the next loaders using the same LoadedPackages.
+func (l *PackageLoader) ReusePackages(loaded *LoadedPackages) {
+	l.loaded = loaded
+}
+
+// loadOrReuse reuses the packages of the previous load, and loads again the packages of the changed files.
+// All the packages are loaded again when the changes can modify the graph of the packages:
+// e.g. a change of go.mod, a new package, a change of the imports, or a change of a dependency.
+func (l *PackageLoader) loadOrReuse(ctx context.Context, loadMode packages.LoadMode) ([]*packages.Package, error) {
+	lp := l.loaded
+
+	changes, all := lp.takeChanges()
+
+	if lp.roots != nil && lp.mode == loadMode && !all {
+		err := l.reloadChanged(ctx, changes)
+		if err == nil {
+			lp.restore(l.loadGuard)
+
+			return lp.roots, nil
+		}
+
+		l.log.Infof("Loading all the packages again: %v", err)
+	}
+
+	lp.roots = nil
+	lp.states = nil
+
+	pkgs, err := l.loadPackages(ctx, loadMode, buildArgs(l.args))
+	if err != nil {
+		return nil, err
+	}
+
+	if isReusableLoadMode(loadMode) {
+		lp.mode = loadMode
+		lp.roots = pkgs
+		lp.track()
+	}
+
+	return pkgs, nil
+}
+
+// reloadChanged loads again the packages of the changed files, and replaces them in the graph of the packages.
+func (l *PackageLoader) reloadChanged(ctx context.Context, changes []string) error {
+	lp := l.loaded
+
+	dirs, err := lp.changedDirs(changes, buildArgs(l.args))
+	if err != nil {
+		return err
+	}
+
+	if len(dirs) == 0 {
+		l.log.Infof("Reusing the loaded packages")
+		return nil
+	}
+
+	pkgs, err := l.loadPackages(ctx, lp.mode, dirs)
+	if err != nil {
+		return err
+	}
+
+	if err = lp.replace(dirs, pkgs); err != nil {
+		return err
+	}
+
+	lp.track()
+
+	l.log.Infof("Reusing the loaded packages, except the %d packages of %d changed files", len(pkgs), len(changes))
+
+	return nil
+}
+
+// changedDirs returns the directories of the root packages containing the changed files.
+// An error is returned when all the packages must be loaded again.
+func (lp *LoadedPackages) changedDirs(changes, args []string) ([]string, error) {
+	rootDirs := map[string]bool{}
+	for _, pkg := range lp.roots {
+		rootDirs[packageDir(pkg)] = true
+	}
+
+	depDirs := map[string]bool{}
+	packages.Visit(lp.roots, nil, func(pkg *packages.Package) {
+		if dir := packageDir(pkg); !rootDirs[dir] {
+			depDirs[dir] = true
+		}
+	})
+
+	var dirs []string
+
+	for _, path := range changes {
+		dir := filepath.Dir(path)
+
+		switch {
+		case rootDirs[dir]:
+			if !slices.Contains(dirs, dir) {
+				dirs = append(dirs, dir)
+			}
+
+		case depDirs[dir]:
+			// The export data of the dependencies, and of their dependents, are built by the loader.
+			return nil, fmt.Errorf("the dependency in %s changed", dir)
+
+		case matchArgs(dir, args):
+			return nil, fmt.Errorf("new package in %s", dir)
+		}
+
+		// Otherwise the file is not used by the packages.
+	}
+
+	return dirs, nil
+}
+
+// replace replaces the root packages of the directories by the packages loaded again.
+func (lp *LoadedPackages) replace(dirs []string, pkgs []*packages.Package) error {
+	var all []*packages.Package
+
+	previous := map[string]*packages.Package{}
+	packages.Visit(lp.roots, nil, func(pkg *packages.Package) {
+		all = append(all, pkg)
+		previous[pkg.ID] = pkg
+	})
+
+	replaced := map[string]*packages.Package{}
+
+	for _, pkg := range pkgs {
+		old, ok := previous[pkg.ID]
+		if !ok || !slices.Contains(lp.roots, old) {
+			return fmt.Errorf("new package %s", pkg.ID)
+		}
+
+		if !sameImports(old, pkg) {
+			return fmt.Errorf("the imports of %s changed", pkg.ID)
+		}
+
+		replaced[pkg.ID] = pkg
+	}
+
+	for _, pkg := range lp.roots {
+		if slices.Contains(dirs, packageDir(pkg)) && replaced[pkg.ID] == nil {
+			return fmt.Errorf("package %s removed", pkg.ID)
+		}
+	}
+
+	// The new packages import the kept packages.
+	for _, pkg := range pkgs {
+		for path, imp := range pkg.Imports {
+			if r, ok := replaced[imp.ID]; ok {
+				pkg.Imports[path] = r
+			} else {
+				pkg.Imports[path] = previous[imp.ID]
+			}
+		}
+	}
+
+	// The kept packages import the new packages.
+	for _, pkg := range all {
+		for path, imp := range pkg.Imports {
+			if r, ok := replaced[imp.ID]; ok {
+				pkg.Imports[path] = r
+			}
+		}
+	}
+
+	for i, pkg := range lp.roots {
+		if r, ok := replaced[pkg.ID]; ok {
+			lp.roots[i] = r
+		}
+	}
+
+	return nil
+}
+
+// track keeps the state of the new packages of the graph, and forgets the removed packages.
+func (lp *LoadedPackages) track() {
+	states := map[*packages.Package]packageState{}
+
+	packages.Visit(lp.roots, nil, func(pkg *packages.Package) {
+		state, ok := lp.states[pkg]
+		if !ok {
+			state = packageState{errors: slices.Clone(pkg.Errors), typesSizes: pkg.TypesSizes}
+		}
+
+		states[pkg] = state
+	})
+
+	lp.states = states
+}
+
+// restore clears the fields set by the linters during the previous run.
+func (lp *LoadedPackages) restore(guard *load.Guard) {
+	fset := token.NewFileSet()
+
+	packages.Visit(lp.roots, nil, func(pkg *packages.Package) {
+		state := lp.states[pkg]
+
+		pkg.Fset = fset
+		pkg.Errors = slices.Clone(state.errors)
+		pkg.TypesSizes = state.typesSizes
+		pkg.Types = nil
+		pkg.TypesInfo = nil
+		pkg.Syntax = nil
+		pkg.IllTyped = false
+
+		guard.AddMutexForPkg(pkg)
+	})
+}
+
+// isReusableLoadMode returns true if the files of the packages are known,
+// and the syntax and the types are loaded by the linters.
+func isReusableLoadMode(mode packages.LoadMode) bool {
+	return mode&packages.NeedFiles != 0 && mode&(packages.NeedSyntax|packages.NeedTypes|packages.NeedTypesInfo) == 0
+}
+
+func sameImports(a, b *packages.Package) bool {
+	if len(a.Imports) != len(b.Imports) {
+		return false
+	}
+
+	for path, imp := range a.Imports {
+		other, ok := b.Imports[path]
+		if !ok || other.ID != imp.ID {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchArgs returns true if a package of the directory can match the arguments (see buildArgs).
+func matchArgs(dir string, args []string) bool {
+	for _, arg := range args {
+		base, recursive := strings.CutSuffix(arg, "...")
+
+		base, err := filepath.Abs(base)
+		if err != nil {
+			continue
+		}
+
+		if dir == base || recursive && strings.HasPrefix(dir, base+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
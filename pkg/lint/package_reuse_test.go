@@ -0,0 +1,116 @@
+package lint
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/tools/go/packages"
+)
+
+func TestLoadedPackages_changedDirs(t *testing.T) {
+	wd := mustAbs(t, ".")
+
+	dep := &packages.Package{ID: "dep", GoFiles: []string{filepath.Join(wd, "dep", "dep.go")}}
+	a := &packages.Package{
+		ID:      "a",
+		GoFiles: []string{filepath.Join(wd, "a", "a.go")},
+		Imports: map[string]*packages.Package{"dep": dep},
+	}
+
+	lp := &LoadedPackages{roots: []*packages.Package{a}}
+
+	testCases := []struct {
+		desc     string
+		changes  []string
+		args     []string
+		expected []string
+		err      bool
+	}{
+		{
+			desc:     "file of a root package",
+			changes:  []string{filepath.Join(wd, "a", "a.go"), filepath.Join(wd, "a", "b.go")},
+			args:     []string{"./..."},
+			expected: []string{filepath.Join(wd, "a")},
+		},
+		{
+			desc:    "file of a dependency",
+			changes: []string{filepath.Join(wd, "dep", "dep.go")},
+			args:    []string{"./a"},
+			err:     true,
+		},
+		{
+			desc:    "new package matching the arguments",
+			changes: []string{filepath.Join(wd, "b", "b.go")},
+			args:    []string{"./..."},
+			err:     true,
+		},
+		{
+			desc:    "file not used by the packages",
+			changes: []string{filepath.Join(wd, "b", "b.go")},
+			args:    []string{"./a"},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			dirs, err := lp.changedDirs(test.changes, test.args)
+			if test.err {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, dirs)
+		})
+	}
+}
+
+func TestLoadedPackages_replace(t *testing.T) {
+	dir := mustAbs(t, "a")
+
+	dep := &packages.Package{ID: "dep", GoFiles: []string{mustAbs(t, "dep/dep.go")}}
+	a := &packages.Package{
+		ID:      "a",
+		GoFiles: []string{filepath.Join(dir, "a.go")},
+		Imports: map[string]*packages.Package{"dep": dep},
+	}
+	b := &packages.Package{
+		ID:      "b",
+		GoFiles: []string{mustAbs(t, "b/b.go")},
+		Imports: map[string]*packages.Package{"a": a},
+	}
+
+	lp := &LoadedPackages{roots: []*packages.Package{a, b}}
+	lp.track()
+
+	// The dependencies are loaded again with the package.
+	newA := &packages.Package{
+		ID:      "a",
+		GoFiles: []string{filepath.Join(dir, "a.go")},
+		Imports: map[string]*packages.Package{"dep": {ID: "dep"}},
+	}
+
+	err := lp.replace([]string{dir}, []*packages.Package{newA})
+	require.NoError(t, err)
+
+	lp.track()
+
+	assert.Equal(t, []*packages.Package{newA, b}, lp.roots)
+	assert.Same(t, dep, newA.Imports["dep"])
+	assert.Same(t, newA, b.Imports["a"])
+
+	_, ok := lp.states[a]
+	assert.False(t, ok)
+
+	_, ok = lp.states[newA]
+	assert.True(t, ok)
+
+	// The imports changed.
+	err = lp.replace([]string{dir}, []*packages.Package{{ID: "a", GoFiles: []string{filepath.Join(dir, "a.go")}}})
+	require.Error(t, err)
+}
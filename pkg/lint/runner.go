@@ -5,11 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"runtime/debug"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/golangci/golangci-lint/internal/errorutil"
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/goanalysis"
 	"github.com/golangci/golangci-lint/pkg/goutil"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
@@ -17,6 +21,7 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 	"github.com/golangci/golangci-lint/pkg/result/processors"
 	"github.com/golangci/golangci-lint/pkg/timeutils"
+	"github.com/golangci/golangci-lint/pkg/tracing"
 )
 
 type processorStat struct {
@@ -24,16 +29,30 @@ type processorStat struct {
 	outCount int
 }
 
+// LinterRun is the result of the run of a linter.
+// The linters combined in the go/analysis metalinter only have an error: their durations are in the timing report.
+type LinterRun struct {
+	Duration time.Duration
+	Err      error
+}
+
 type Runner struct {
 	Log logutils.Log
 
 	lintCtx    *linter.Context
 	Processors []processors.Processor
+
+	// scopeRuns are the linters of the nested configurations, and the packages they analyze.
+	scopeRuns []scopeRun
+
+	linterRuns map[string]LinterRun
+
+	budgets *processors.Budgets
 }
 
 func NewRunner(log logutils.Log, cfg *config.Config, args []string, goenv *goutil.Env,
 	lineCache *fsutils.LineCache, fileCache *fsutils.FileCache,
-	dbManager *lintersdb.Manager, lintCtx *linter.Context,
+	dbManager *lintersdb.Manager, lintCtx *linter.Context, scopes []*Scope,
 ) (*Runner, error) {
 	// Beware that some processors need to add the path prefix when working with paths
 	// because they get invoked before the path prefixer (exclude and severity rules)
@@ -60,6 +79,48 @@ func NewRunner(log logutils.Log, cfg *config.Config, args []string, goenv *gouti
 		return nil, fmt.Errorf("failed to get enabled linters: %w", err)
 	}
 
+	budgets := processors.NewBudgets(log.Child(logutils.DebugKeyBudgets), files, &cfg.Issues)
+
+	unusedExclusions := processors.NewUnusedExclusions(log.Child(logutils.DebugKeyUnusedExclusions), &cfg.Issues)
+	expiredExcludeRules := processors.NewExpiredExcludeRules(log.Child(logutils.DebugKeyExcludeRules), &cfg.Issues)
+
+	// The positions of the exclusions are used to report the unused exclusions and the expired exclude rules.
+	exclusionPositions := func(c *config.Config, key string) []string {
+		if !cfg.Issues.ReportUnusedExclusions && key != "issues.exclude-rules" {
+			return nil
+		}
+
+		return c.ListPositions(key)
+	}
+
+	// The exclusions, the nolint directives, and the severities use the nearest configuration.
+	var nestedExclude, nestedExcludeRules, nestedNolint, nestedSeverity []processors.Processor
+
+	for _, scope := range scopes {
+		scopeEnabledLinters, err := scope.DBManager.GetEnabledLintersMap()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get enabled linters: %w", err)
+		}
+
+		nestedExclude = append(nestedExclude,
+			processors.NewExclude(&scope.Cfg.Issues, unusedExclusions, exclusionPositions(scope.Cfg, "issues.exclude")))
+		nestedExcludeRules = append(nestedExcludeRules,
+			processors.NewExcludeRules(log.Child(logutils.DebugKeyExcludeRules), files, &scope.Cfg.Issues,
+				unusedExclusions, expiredExcludeRules, exclusionPositions(scope.Cfg, "issues.exclude-rules")))
+		nestedNolint = append(nestedNolint,
+			processors.NewNolint(log.Child(logutils.DebugKeyNolint), scope.DBManager, scopeEnabledLinters, &scope.Cfg.Issues))
+		nestedSeverity = append(nestedSeverity,
+			processors.NewSeverity(log.Child(logutils.DebugKeySeverityRules), files, &scope.Cfg.Severity, scopeEnabledLinters))
+	}
+
+	// Must be after the processors using all the packages (FilenameUnadjuster).
+	rootCtx, scopeCtxs := splitContext(lintCtx, scopes)
+
+	var scopeRuns []scopeRun
+	for i, scope := range scopes {
+		scopeRuns = append(scopeRuns, scopeRun{lintCtx: scopeCtxs[i], linters: scope.Linters})
+	}
+
 	return &Runner{
 		Processors: []processors.Processor{
 			processors.NewCgo(goenv),
@@ -80,18 +141,39 @@ func NewRunner(log logutils.Log, cfg *config.Config, args []string, goenv *gouti
 			// Must be before exclude because users see already marked output and configure excluding by it.
 			processors.NewIdentifierMarker(),
 
-			processors.NewExclude(&cfg.Issues),
-			processors.NewExcludeRules(log.Child(logutils.DebugKeyExcludeRules), files, &cfg.Issues),
-			processors.NewNolint(log.Child(logutils.DebugKeyNolint), dbManager, enabledLinters),
+			processors.NewNested(cfg,
+				processors.NewExclude(&cfg.Issues, unusedExclusions, exclusionPositions(cfg, "issues.exclude")), nestedExclude),
+			processors.NewNested(cfg,
+				processors.NewExcludeRules(log.Child(logutils.DebugKeyExcludeRules), files, &cfg.Issues,
+					unusedExclusions, expiredExcludeRules, exclusionPositions(cfg, "issues.exclude-rules")), nestedExcludeRules),
+			// Must be after Exclude and ExcludeRules.
+			unusedExclusions,
+			expiredExcludeRules,
+			processors.NewNested(cfg,
+				processors.NewNolint(log.Child(logutils.DebugKeyNolint), dbManager, enabledLinters, &cfg.Issues), nestedNolint),
 
 			processors.NewUniqByLine(cfg),
 			processors.NewDiff(&cfg.Issues),
+
+			// Must be before the Fingerprinter: the fingerprint contains the text.
+			processors.NewPathShortener(),
+
+			// Must be before the Baseline: the baseline identifies the issues by their fingerprints.
+			// Must be before the processors limiting the number of issues: the identical issues are disambiguated by their order.
+			processors.NewFingerprinter(log.Child(logutils.DebugKeyFingerprint), fileCache, lineCache),
+
+			// Must be before the processors limiting the number of issues: the baseline records all the issues.
+			processors.NewBaseline(log.Child(logutils.DebugKeyBaseline), &cfg.Issues),
+
+			// Must be before the processors limiting the number of issues: the budgets count all the issues.
+			budgets,
+
 			processors.NewMaxPerFileFromLinter(cfg),
 			processors.NewMaxSameIssues(cfg.Issues.MaxSameIssues, log.Child(logutils.DebugKeyMaxSameIssues), cfg),
 			processors.NewMaxFromLinter(cfg.Issues.MaxIssuesPerLinter, log.Child(logutils.DebugKeyMaxFromLinter), cfg),
 			processors.NewSourceCode(lineCache, log.Child(logutils.DebugKeySourceCode)),
-			processors.NewPathShortener(),
-			processors.NewSeverity(log.Child(logutils.DebugKeySeverityRules), files, &cfg.Severity),
+			processors.NewNested(cfg,
+				processors.NewSeverity(log.Child(logutils.DebugKeySeverityRules), files, &cfg.Severity, enabledLinters), nestedSeverity),
 
 			// The fixer still needs to see paths for the issues that are relative to the current directory.
 			processors.NewFixer(cfg, log, fileCache),
@@ -100,11 +182,24 @@ func NewRunner(log logutils.Log, cfg *config.Config, args []string, goenv *gouti
 			processors.NewPathPrefixer(cfg.Output.PathPrefix),
 			processors.NewSortResults(cfg),
 		},
-		lintCtx: lintCtx,
-		Log:     log,
+		lintCtx:    rootCtx,
+		scopeRuns:  scopeRuns,
+		Log:        log,
+		linterRuns: map[string]LinterRun{},
+		budgets:    budgets,
 	}, nil
 }
 
+// LinterRuns returns the durations and the errors of the linters, by name.
+func (r *Runner) LinterRuns() map[string]LinterRun {
+	return r.linterRuns
+}
+
+// Budgets returns the budgets of the issues (`issues.budgets`).
+func (r *Runner) Budgets() *processors.Budgets {
+	return r.budgets
+}
+
 func (r *Runner) Run(ctx context.Context, linters []*linter.Config) ([]result.Issue, error) {
 	sw := timeutils.NewStopwatch("linters", r.Log)
 	defer sw.Print()
@@ -114,21 +209,151 @@ func (r *Runner) Run(ctx context.Context, linters []*linter.Config) ([]result.Is
 		issues     []result.Issue
 	)
 
-	for _, lc := range linters {
-		sw.TrackStage(lc.Name(), func() {
-			linterIssues, err := r.runLinterSafe(ctx, r.lintCtx, lc)
+	for _, run := range r.runs(linters) {
+		for _, lc := range run.linters {
+			linterIssues, err := r.runLinter(ctx, run.lintCtx, lc, sw)
 			if err != nil {
 				lintErrors = errors.Join(lintErrors, fmt.Errorf("can't run linter %s", lc.Linter.Name()), err)
-				r.Log.Warnf("Can't run linter %s: %v", lc.Linter.Name(), err)
-
-				return
+				continue
 			}
 
 			issues = append(issues, linterIssues...)
+		}
+	}
+
+	var outIssues []result.Issue
+	tracing.Track(ctx, "processing", func(ctx context.Context) {
+		outIssues = r.processLintResults(ctx, issues)
+	})
+
+	return outIssues, lintErrors
+}
+
+// RunStream runs the linters, and processes and emits the issues as soon as they are found:
+// the issues of the go/analysis linters are emitted as soon as each analyzer finishes each package (see linter.Context.IssuesStream),
+// the issues of the other linters are emitted when the linter finishes.
+// The processors keep their state between the batches of issues:
+// the limits (max-same-issues, max-issues-per-linter, etc.) apply to the issues in the order of the batches.
+// The issues emitted by a linter before its failure are kept: without streaming, the issues of a failed linter are dropped.
+// Returns all the emitted issues.
+func (r *Runner) RunStream(ctx context.Context, linters []*linter.Config, emit func(issues []result.Issue) error) ([]result.Issue, error) {
+	sw := timeutils.NewStopwatch("linters", r.Log)
+	defer sw.Print()
+
+	processingSW := timeutils.NewStopwatch("processing", r.Log)
+	statPerProcessor := map[string]processorStat{}
+
+	var (
+		lintErrors                error
+		outIssues                 []result.Issue
+		issuesBefore, issuesAfter int
+
+		// The batches are sent by the analyzers running in parallel.
+		mu      sync.Mutex
+		emitErr error
+	)
+
+	process := func(batch []result.Issue) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if emitErr != nil {
+			return
+		}
+
+		var issues []result.Issue
+		if len(batch) != 0 {
+			tracing.Track(ctx, "processing", func(ctx context.Context) {
+				issues = r.processIssues(ctx, batch, processingSW, statPerProcessor)
+			})
+		}
+
+		issuesBefore += len(batch)
+		issuesAfter += len(issues)
+
+		if err := emit(issues); err != nil {
+			emitErr = err
+			return
+		}
+
+		outIssues = append(outIssues, issues...)
+	}
+
+	for _, run := range r.runs(linters) {
+		run.lintCtx.IssuesStream = process
+
+		for _, lc := range run.linters {
+			linterIssues, err := r.runLinter(ctx, run.lintCtx, lc, sw)
+			if err != nil {
+				lintErrors = errors.Join(lintErrors, fmt.Errorf("can't run linter %s", lc.Linter.Name()), err)
+			} else {
+				process(linterIssues)
+			}
+
+			if emitErr != nil {
+				run.lintCtx.IssuesStream = nil
+				return nil, emitErr
+			}
+		}
+
+		run.lintCtx.IssuesStream = nil
+	}
+
+	r.finishProcessing(processingSW, statPerProcessor, issuesBefore, issuesAfter)
+
+	return outIssues, lintErrors
+}
+
+// runs returns the linters with the context of the packages they analyze:
+// the linters of the root configuration first, then the linters of the nested configurations.
+// The nested configurations without packages are skipped.
+func (r *Runner) runs(linters []*linter.Config) []scopeRun {
+	runs := []scopeRun{{lintCtx: r.lintCtx, linters: linters}}
+	if len(r.scopeRuns) == 0 {
+		return runs
+	}
+
+	runs = append(runs, r.scopeRuns...)
+
+	return slices.DeleteFunc(runs, func(run scopeRun) bool {
+		return len(run.lintCtx.Packages) == 0
+	})
+}
+
+func (r *Runner) runLinter(ctx context.Context, lintCtx *linter.Context, lc *linter.Config,
+	sw *timeutils.Stopwatch,
+) ([]result.Issue, error) {
+	var (
+		issues []result.Issue
+		err    error
+	)
+
+	sw.TrackStage(lc.Name(), func() {
+		startedAt := time.Now()
+
+		tracing.Track(ctx, lc.Name(), func(ctx context.Context) {
+			issues, err = r.runLinterSafe(ctx, lintCtx, lc)
 		})
+
+		// The linters of the nested configurations run several times.
+		previous := r.linterRuns[lc.Name()]
+		r.linterRuns[lc.Name()] = LinterRun{Duration: previous.Duration + time.Since(startedAt), Err: errors.Join(previous.Err, err)}
+
+		// The issues of all the linters combined in the metalinter are lost: they all fail.
+		if ml, ok := lc.Linter.(*goanalysis.MetaLinter); ok && err != nil {
+			for _, name := range ml.LinterNames() {
+				previous := r.linterRuns[name]
+				r.linterRuns[name] = LinterRun{Err: errors.Join(previous.Err, err)}
+			}
+		}
+	})
+
+	if err != nil {
+		r.Log.Warnf("Can't run linter %s: %v", lc.Linter.Name(), err)
+		return nil, err
 	}
 
-	return r.processLintResults(issues), lintErrors
+	return issues, nil
 }
 
 func (r *Runner) runLinterSafe(ctx context.Context, lintCtx *linter.Context,
@@ -172,7 +397,7 @@ func (r *Runner) runLinterSafe(ctx context.Context, lintCtx *linter.Context,
 	return issues, nil
 }
 
-func (r *Runner) processLintResults(inIssues []result.Issue) []result.Issue {
+func (r *Runner) processLintResults(ctx context.Context, inIssues []result.Issue) []result.Issue {
 	sw := timeutils.NewStopwatch("processing", r.Log)
 
 	var issuesBefore, issuesAfter int
@@ -181,10 +406,16 @@ func (r *Runner) processLintResults(inIssues []result.Issue) []result.Issue {
 	var outIssues []result.Issue
 	if len(inIssues) != 0 {
 		issuesBefore += len(inIssues)
-		outIssues = r.processIssues(inIssues, sw, statPerProcessor)
+		outIssues = r.processIssues(ctx, inIssues, sw, statPerProcessor)
 		issuesAfter += len(outIssues)
 	}
 
+	r.finishProcessing(sw, statPerProcessor, issuesBefore, issuesAfter)
+
+	return outIssues
+}
+
+func (r *Runner) finishProcessing(sw *timeutils.Stopwatch, statPerProcessor map[string]processorStat, issuesBefore, issuesAfter int) {
 	// finalize processors: logging, clearing, no heavy work here
 
 	for _, p := range r.Processors {
@@ -198,8 +429,6 @@ func (r *Runner) processLintResults(inIssues []result.Issue) []result.Issue {
 	}
 	r.printPerProcessorStat(statPerProcessor)
 	sw.PrintStages()
-
-	return outIssues
 }
 
 func (r *Runner) printPerProcessorStat(stat map[string]processorStat) {
@@ -214,12 +443,16 @@ func (r *Runner) printPerProcessorStat(stat map[string]processorStat) {
 	}
 }
 
-func (r *Runner) processIssues(issues []result.Issue, sw *timeutils.Stopwatch, statPerProcessor map[string]processorStat) []result.Issue {
+func (r *Runner) processIssues(ctx context.Context, issues []result.Issue, sw *timeutils.Stopwatch,
+	statPerProcessor map[string]processorStat,
+) []result.Issue {
 	for _, p := range r.Processors {
 		var newIssues []result.Issue
 		var err error
 		sw.TrackStage(p.Name(), func() {
-			newIssues, err = p.Process(issues)
+			tracing.Track(ctx, p.Name(), func(_ context.Context) {
+				newIssues, err = p.Process(issues)
+			})
 		})
 
 		if err != nil {
@@ -17,14 +17,18 @@ const envDebug = "GL_DEBUG"
 
 const (
 	DebugKeyAutogenExclude     = "autogen_exclude" // Debugs a filter excluding autogenerated source code.
+	DebugKeyBaseline           = "baseline"
 	DebugKeyBinSalt            = "bin_salt"
+	DebugKeyBudgets            = "budgets"
 	DebugKeyConfigReader       = "config_reader"
+	DebugKeyDaemon             = "daemon"
 	DebugKeyEmpty              = ""
 	DebugKeyEnabledLinters     = "enabled_linters"
 	DebugKeyEnv                = "env" // Debugs `go env` command.
 	DebugKeyExcludeRules       = "exclude_rules"
 	DebugKeyExec               = "exec"
 	DebugKeyFilenameUnadjuster = "filename_unadjuster"
+	DebugKeyFingerprint        = "fingerprint"
 	DebugKeyInvalidIssue       = "invalid_issue"
 	DebugKeyForbidigo          = "forbidigo"
 	DebugKeyGoEnv              = "goenv"
@@ -33,6 +37,7 @@ const (
 	DebugKeyLintersDB          = "lintersdb"
 	DebugKeyLintersOutput      = "linters_output"
 	DebugKeyLoader             = "loader" // Debugs packages loading (including `go/packages` internal debugging).
+	DebugKeyLSP                = "lsp"
 	DebugKeyMaxFromLinter      = "max_from_linter"
 	DebugKeyMaxSameIssues      = "max_same_issues"
 	DebugKeyPkgCache           = "pkgcache"
@@ -44,6 +49,8 @@ const (
 	DebugKeyTabPrinter         = "tab_printer"
 	DebugKeyTest               = "test"
 	DebugKeyTextPrinter        = "text_printer"
+	DebugKeyTriage             = "triage"
+	DebugKeyUnusedExclusions   = "unused_exclusions"
 )
 
 const (
@@ -0,0 +1,184 @@
+package lsp
+
+import (
+	"bytes"
+	"net/url"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const diagnosticSource = "golangci-lint"
+
+func uriToPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+
+	path := u.Path
+	if runtime.GOOS == "windows" {
+		// file:///C:/foo -> C:/foo
+		path = strings.TrimPrefix(path, "/")
+	}
+
+	return filepath.FromSlash(path), nil
+}
+
+func pathToURI(path string) string {
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		// Windows drive letter.
+		path = "/" + path
+	}
+
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
+
+// document gives access to the lines of a file to convert the golangci-lint positions (1-based lines, byte columns)
+// into LSP positions (0-based lines, UTF-16 columns).
+type document struct {
+	content []byte
+	lines   [][]byte
+}
+
+func newDocument(content []byte) *document {
+	return &document{
+		content: content,
+		lines:   bytes.Split(content, []byte("\n")),
+	}
+}
+
+func (d *document) line(index0 int) []byte {
+	if index0 < 0 || index0 >= len(d.lines) {
+		return nil
+	}
+
+	return d.lines[index0]
+}
+
+// position converts a 0-based line and a 0-based byte column.
+func (d *document) position(line, col int) position {
+	text := d.line(line)
+	col = min(max(col, 0), len(text))
+
+	return position{Line: max(line, 0), Character: utf16Len(text[:col])}
+}
+
+// offsetPosition converts a 0-based byte offset.
+func (d *document) offsetPosition(offset int) position {
+	offset = min(max(offset, 0), len(d.content))
+
+	line := bytes.Count(d.content[:offset], []byte("\n"))
+	lineStart := bytes.LastIndexByte(d.content[:offset], '\n') + 1
+
+	return d.position(line, offset-lineStart)
+}
+
+func (d *document) lineEnd(line int) position {
+	return d.position(line, len(d.line(line)))
+}
+
+func utf16Len(b []byte) int {
+	n := 0
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		if r >= 0x10000 {
+			n += 2 // surrogate pair
+		} else {
+			n++
+		}
+
+		b = b[size:]
+	}
+
+	return n
+}
+
+func toDiagnostic(issue *result.Issue, doc *document) diagnostic {
+	line := issue.Line() - 1
+
+	var rng lspRange
+	if issue.Column() > 0 {
+		start := doc.position(line, issue.Column()-1)
+		rng = lspRange{Start: start, End: start}
+	} else {
+		// The whole line.
+		rng = lspRange{Start: doc.position(line, 0), End: doc.lineEnd(line)}
+	}
+
+	if issue.LineRange != nil && issue.LineRange.To > issue.Line() {
+		rng.End = doc.lineEnd(issue.LineRange.To - 1)
+	}
+
+	return diagnostic{
+		Range:    rng,
+		Severity: toSeverity(issue.Severity),
+		Code:     issue.FromLinter,
+		Source:   diagnosticSource,
+		Message:  issue.Text,
+	}
+}
+
+func toSeverity(severity string) int {
+	switch config.NormalizeSeverity(severity) {
+	case config.SeverityError:
+		return severityError
+	case config.SeverityInfo:
+		return severityInformation
+	case config.SeverityHint:
+		return severityHint
+	default:
+		return severityWarning
+	}
+}
+
+// toTextEdits converts the replacement of the issue.
+func toTextEdits(issue *result.Issue, doc *document) []textEdit {
+	r := issue.Replacement
+	if r == nil {
+		return nil
+	}
+
+	if len(r.TextEdits) != 0 {
+		edits := make([]textEdit, 0, len(r.TextEdits))
+		for _, e := range r.TextEdits {
+			edits = append(edits, textEdit{
+				Range:   lspRange{Start: doc.offsetPosition(e.Pos), End: doc.offsetPosition(e.End)},
+				NewText: e.NewText,
+			})
+		}
+
+		return edits
+	}
+
+	line := issue.Line() - 1
+
+	if r.Inline != nil {
+		return []textEdit{{
+			Range: lspRange{
+				Start: doc.position(line, r.Inline.StartCol),
+				End:   doc.position(line, r.Inline.StartCol+r.Inline.Length),
+			},
+			NewText: r.Inline.NewString,
+		}}
+	}
+
+	lineRange := issue.GetLineRange()
+	start := position{Line: lineRange.From - 1}
+
+	if r.NeedOnlyDelete {
+		return []textEdit{{
+			Range: lspRange{Start: start, End: position{Line: lineRange.To}},
+		}}
+	}
+
+	return []textEdit{{
+		Range:   lspRange{Start: start, End: doc.lineEnd(lineRange.To - 1)},
+		NewText: strings.Join(r.NewLines, "\n"),
+	}}
+}
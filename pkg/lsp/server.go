@@ -0,0 +1,326 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// LintFunc lints the package in the directory.
+type LintFunc func(ctx context.Context, dir string) ([]result.Issue, error)
+
+// ChangeFunc marks a saved file as changed, before the lint of its package.
+type ChangeFunc func(path string)
+
+// Server is a Language Server Protocol server publishing the issues as diagnostics
+// and their replacements as code actions.
+//
+// A package is linted when one of its files is opened or saved:
+// the configuration is loaded once, the unchanged packages and their results are kept in memory.
+type Server struct {
+	log     logutils.Log
+	conn    *conn
+	lint    LintFunc
+	changed ChangeFunc
+	version string
+
+	wake chan struct{}
+
+	mu sync.Mutex
+	// Directories waiting to be linted.
+	pending []string
+	// Issues of the last lint per file (absolute path).
+	issues map[string][]result.Issue
+	// Files with published diagnostics per directory.
+	published map[string][]string
+}
+
+func NewServer(log logutils.Log, r io.Reader, w io.Writer, version string, lint LintFunc, changed ChangeFunc) *Server {
+	return &Server{
+		log:       log,
+		conn:      newConn(r, w),
+		lint:      lint,
+		changed:   changed,
+		version:   version,
+		wake:      make(chan struct{}, 1),
+		issues:    map[string][]result.Issue{},
+		published: map[string][]string{},
+	}
+}
+
+// Run serves the requests until the exit notification or the end of the input.
+func (s *Server) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go s.lintLoop(ctx)
+
+	for {
+		msg, err := s.conn.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			var respErr *responseError
+			if errors.As(err, &respErr) {
+				s.log.Warnf("Invalid message: %v", err)
+				_ = s.conn.reply(nil, nil, respErr)
+				continue
+			}
+
+			return err
+		}
+
+		if msg.Method == "" {
+			continue // response to a server request: ignored.
+		}
+
+		if msg.Method == methodExit {
+			return nil
+		}
+
+		res, respErr := s.handle(msg)
+
+		if msg.ID == nil {
+			if respErr != nil {
+				s.log.Warnf("Failed to handle %s: %v", msg.Method, respErr)
+			}
+
+			continue
+		}
+
+		if err = s.conn.reply(msg.ID, res, respErr); err != nil {
+			return fmt.Errorf("failed to reply to %s: %w", msg.Method, err)
+		}
+	}
+}
+
+func (s *Server) handle(msg *message) (any, *responseError) {
+	switch msg.Method {
+	case methodInitialize:
+		return initializeResult{
+			Capabilities: serverCapabilities{
+				TextDocumentSync:   textDocumentSyncOptions{OpenClose: true, Save: true},
+				CodeActionProvider: true,
+			},
+			ServerInfo: serverInfo{Name: "golangci-lint", Version: s.version},
+		}, nil
+
+	case methodInitialized, methodDidClose, methodShutdown:
+		return nil, nil
+
+	case methodDidOpen, methodDidSave:
+		var params textDocumentParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, &responseError{Code: codeInvalidParams, Message: err.Error()}
+		}
+
+		path, err := uriToPath(params.TextDocument.URI)
+		if err != nil {
+			return nil, &responseError{Code: codeInvalidParams, Message: err.Error()}
+		}
+
+		if filepath.Ext(path) != ".go" {
+			return nil, nil
+		}
+
+		if msg.Method == methodDidSave {
+			s.changed(path)
+		}
+
+		s.schedule(filepath.Dir(path))
+
+		return nil, nil
+
+	case methodCodeAction:
+		var params codeActionParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return nil, &responseError{Code: codeInvalidParams, Message: err.Error()}
+		}
+
+		actions, err := s.codeActions(&params)
+		if err != nil {
+			return nil, &responseError{Code: codeInternalError, Message: err.Error()}
+		}
+
+		return actions, nil
+
+	default:
+		return nil, &responseError{Code: codeMethodNotFound, Message: "method not found: " + msg.Method}
+	}
+}
+
+func (s *Server) schedule(dir string) {
+	s.mu.Lock()
+	if !slices.Contains(s.pending, dir) {
+		s.pending = append(s.pending, dir)
+	}
+	s.mu.Unlock()
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// lintLoop lints the pending directories one by one.
+func (s *Server) lintLoop(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+		}
+
+		for {
+			s.mu.Lock()
+			if len(s.pending) == 0 {
+				s.mu.Unlock()
+				break
+			}
+
+			dir := s.pending[0]
+			s.pending = s.pending[1:]
+			s.mu.Unlock()
+
+			if err := s.lintDir(ctx, dir); err != nil {
+				s.log.Warnf("Failed to lint %s: %v", dir, err)
+			}
+		}
+	}
+}
+
+func (s *Server) lintDir(ctx context.Context, dir string) error {
+	issues, err := s.lint(ctx, dir)
+	if err != nil {
+		return err
+	}
+
+	perFile := map[string][]result.Issue{}
+	for i := range issues {
+		path, err := filepath.Abs(issues[i].FilePath())
+		if err != nil {
+			continue
+		}
+
+		perFile[path] = append(perFile[path], issues[i])
+	}
+
+	s.mu.Lock()
+	previous := s.published[dir]
+	s.published[dir] = nil
+
+	for path, fileIssues := range perFile {
+		s.issues[path] = fileIssues
+		s.published[dir] = append(s.published[dir], path)
+	}
+
+	for _, path := range previous {
+		if _, ok := perFile[path]; !ok {
+			delete(s.issues, path)
+		}
+	}
+	s.mu.Unlock()
+
+	for path, fileIssues := range perFile {
+		if err := s.publish(path, fileIssues); err != nil {
+			return err
+		}
+	}
+
+	// Clear the diagnostics of the fixed files.
+	for _, path := range previous {
+		if _, ok := perFile[path]; ok {
+			continue
+		}
+
+		if err := s.publish(path, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) publish(path string, issues []result.Issue) error {
+	diagnostics := make([]diagnostic, 0, len(issues))
+
+	if len(issues) > 0 {
+		doc, err := readDocument(path)
+		if err != nil {
+			return err
+		}
+
+		for i := range issues {
+			diagnostics = append(diagnostics, toDiagnostic(&issues[i], doc))
+		}
+	}
+
+	return s.conn.notify(methodPublishDiagnostics, publishDiagnosticsParams{
+		URI:         pathToURI(path),
+		Diagnostics: diagnostics,
+	})
+}
+
+func (s *Server) codeActions(params *codeActionParams) ([]codeAction, error) {
+	path, err := uriToPath(params.TextDocument.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	issues := s.issues[path]
+	s.mu.Unlock()
+
+	actions := []codeAction{}
+
+	var doc *document
+	for i := range issues {
+		issue := &issues[i]
+		if issue.Replacement == nil {
+			continue
+		}
+
+		rng := issue.GetLineRange()
+		if rng.To-1 < params.Range.Start.Line || rng.From-1 > params.Range.End.Line {
+			continue
+		}
+
+		if doc == nil {
+			doc, err = readDocument(path)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		actions = append(actions, codeAction{
+			Title:       fmt.Sprintf("Fix: %s (%s)", issue.Text, issue.FromLinter),
+			Kind:        codeActionKindQuickFix,
+			Diagnostics: []diagnostic{toDiagnostic(issue, doc)},
+			Edit: &workspaceEdit{
+				Changes: map[string][]textEdit{params.TextDocument.URI: toTextEdits(issue, doc)},
+			},
+		})
+	}
+
+	return actions, nil
+}
+
+func readDocument(path string) (*document, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return newDocument(content), nil
+}
@@ -0,0 +1,175 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestServer(t *testing.T) {
+	dir := t.TempDir()
+
+	filePath := filepath.Join(dir, "a.go")
+
+	err := os.WriteFile(filePath, []byte("package a\n\n// héllo\nvar foo = 1\n"), 0o600)
+	require.NoError(t, err)
+
+	lint := func(_ context.Context, lintDir string) ([]result.Issue, error) {
+		assert.Equal(t, dir, lintDir)
+
+		return []result.Issue{{
+			FromLinter: "linter",
+			Text:       "foo is foo",
+			Pos:        token.Position{Filename: filePath, Line: 4, Column: 5},
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 4, Length: 3, NewString: "bar"},
+			},
+		}}, nil
+	}
+
+	var changed []string
+
+	clientR, serverW := io.Pipe()
+	serverR, clientW := io.Pipe()
+
+	server := NewServer(logutils.NewStderrLog(logutils.DebugKeyEmpty), serverR, serverW, "test", lint,
+		func(path string) { changed = append(changed, path) })
+
+	done := make(chan error, 1)
+	go func() { done <- server.Run(context.Background()) }()
+
+	client := newConn(clientR, clientW)
+
+	uri := pathToURI(filePath)
+
+	call(t, client, 1, methodInitialize, map[string]any{})
+
+	resp := readMessage(t, client)
+	assert.JSONEq(t, `{"capabilities":{"textDocumentSync":{"openClose":true,"change":0,"save":true},"codeActionProvider":true},`+
+		`"serverInfo":{"name":"golangci-lint","version":"test"}}`, marshal(t, resp.Result))
+
+	call(t, client, 0, methodDidOpen, textDocumentParams{TextDocument: textDocumentIdentifier{URI: uri}})
+
+	notification := readMessage(t, client)
+	assert.Equal(t, methodPublishDiagnostics, notification.Method)
+
+	var diagnostics publishDiagnosticsParams
+	require.NoError(t, json.Unmarshal(notification.Params, &diagnostics))
+
+	expectedDiagnostic := diagnostic{
+		Range:    lspRange{Start: position{Line: 3, Character: 4}, End: position{Line: 3, Character: 4}},
+		Severity: severityWarning,
+		Code:     "linter",
+		Source:   diagnosticSource,
+		Message:  "foo is foo",
+	}
+
+	assert.Equal(t, publishDiagnosticsParams{URI: uri, Diagnostics: []diagnostic{expectedDiagnostic}}, diagnostics)
+
+	call(t, client, 2, methodCodeAction, codeActionParams{
+		TextDocument: textDocumentIdentifier{URI: uri},
+		Range:        lspRange{Start: position{Line: 3}, End: position{Line: 3, Character: 3}},
+	})
+
+	resp = readMessage(t, client)
+
+	var actions []codeAction
+	require.NoError(t, json.Unmarshal([]byte(marshal(t, resp.Result)), &actions))
+
+	expectedActions := []codeAction{{
+		Title:       "Fix: foo is foo (linter)",
+		Kind:        codeActionKindQuickFix,
+		Diagnostics: []diagnostic{expectedDiagnostic},
+		Edit: &workspaceEdit{Changes: map[string][]textEdit{uri: {{
+			Range:   lspRange{Start: position{Line: 3, Character: 4}, End: position{Line: 3, Character: 7}},
+			NewText: "bar",
+		}}}},
+	}}
+
+	assert.Equal(t, expectedActions, actions)
+
+	// The saved file is marked as changed before the lint.
+	call(t, client, 0, methodDidSave, textDocumentParams{TextDocument: textDocumentIdentifier{URI: uri}})
+
+	notification = readMessage(t, client)
+	assert.Equal(t, methodPublishDiagnostics, notification.Method)
+	assert.Equal(t, []string{filePath}, changed)
+
+	call(t, client, 3, "unknown", nil)
+
+	resp = readMessage(t, client)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, codeMethodNotFound, resp.Error.Code)
+
+	call(t, client, 4, methodShutdown, nil)
+	readMessage(t, client)
+
+	call(t, client, 0, methodExit, nil)
+
+	require.NoError(t, <-done)
+}
+
+func Test_document_offsetPosition(t *testing.T) {
+	doc := newDocument([]byte("package a\n\n// 😀 é\nvar a = 1\n"))
+
+	testCases := []struct {
+		offset   int
+		expected position
+	}{
+		{offset: 0, expected: position{Line: 0, Character: 0}},
+		{offset: 10, expected: position{Line: 1, Character: 0}},
+		{offset: 18, expected: position{Line: 2, Character: 5}}, // after the emoji: 4 bytes, 2 UTF-16 code units.
+		{offset: 21, expected: position{Line: 2, Character: 7}}, // end of line: é is 2 bytes, 1 UTF-16 code unit.
+		{offset: 100, expected: position{Line: 4, Character: 0}},
+	}
+
+	for _, test := range testCases {
+		assert.Equal(t, test.expected, doc.offsetPosition(test.offset))
+	}
+}
+
+// call sends a request, or a notification if the ID is 0.
+func call(t *testing.T, client *conn, id int, method string, params any) {
+	t.Helper()
+
+	msg := &message{Method: method}
+
+	if params != nil {
+		msg.Params = json.RawMessage(marshal(t, params))
+	}
+
+	if id != 0 {
+		raw := json.RawMessage(marshal(t, id))
+		msg.ID = &raw
+	}
+
+	require.NoError(t, client.write(msg))
+}
+
+func readMessage(t *testing.T, client *conn) *message {
+	t.Helper()
+
+	msg, err := client.read()
+	require.NoError(t, err)
+
+	return msg
+}
+
+func marshal(t *testing.T, v any) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+
+	return string(data)
+}
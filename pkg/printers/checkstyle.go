@@ -12,8 +12,6 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-const defaultCheckstyleSeverity = "error"
-
 type checkstyleOutput struct {
 	XMLName xml.Name          `xml:"checkstyle"`
 	Version string            `xml:"version,attr"`
@@ -59,10 +57,7 @@ func (p Checkstyle) Print(issues []result.Issue) error {
 			files[issue.FilePath()] = file
 		}
 
-		severity := defaultCheckstyleSeverity
-		if issue.Severity != "" {
-			severity = issue.Severity
-		}
+		severity := checkstyleSeverities.convert(issue.Severity)
 
 		newError := &checkstyleError{
 			Column:   issue.Column(),
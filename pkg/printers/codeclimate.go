@@ -7,8 +7,6 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-const defaultCodeClimateSeverity = "critical"
-
 // CodeClimateIssue is a subset of the Code Climate spec.
 // https://github.com/codeclimate/platform/blob/master/spec/analyzers/SPEC.md#data-types
 // It is just enough to support GitLab CI Code Quality.
@@ -41,12 +39,11 @@ func (p CodeClimate) Print(issues []result.Issue) error {
 		codeClimateIssue.Description = issue.Description()
 		codeClimateIssue.Location.Path = issue.Pos.Filename
 		codeClimateIssue.Location.Lines.Begin = issue.Pos.Line
-		codeClimateIssue.Fingerprint = issue.Fingerprint()
-		codeClimateIssue.Severity = defaultCodeClimateSeverity
-
-		if issue.Severity != "" {
-			codeClimateIssue.Severity = issue.Severity
+		codeClimateIssue.Fingerprint = issue.StableFingerprint
+		if codeClimateIssue.Fingerprint == "" {
+			codeClimateIssue.Fingerprint = issue.Fingerprint()
 		}
+		codeClimateIssue.Severity = codeClimateSeverities.convert(issue.Severity)
 
 		codeClimateIssues = append(codeClimateIssues, codeClimateIssue)
 	}
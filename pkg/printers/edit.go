@@ -0,0 +1,123 @@
+package printers
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// position is a 1-based position: the column is a count of bytes.
+type position struct {
+	Line   int
+	Column int
+}
+
+// edit replaces the text between Start and End (exclusive) with NewText.
+type edit struct {
+	Start   position
+	End     position
+	NewText string
+}
+
+// sourceFiles caches the contents of the files.
+// The contents of the files are only read to convert the offsets of the text edits.
+type sourceFiles map[string][]byte
+
+func (f sourceFiles) read(path string) ([]byte, bool) {
+	content, ok := f[path]
+	if !ok {
+		content, _ = os.ReadFile(path)
+		f[path] = content
+	}
+
+	return content, content != nil
+}
+
+// toEdits converts the replacement of the issue into edits.
+func toEdits(issue *result.Issue, files sourceFiles) []edit {
+	r := issue.Replacement
+	if r == nil {
+		return nil
+	}
+
+	if len(r.TextEdits) != 0 {
+		content, ok := files.read(issue.RealFilePath())
+		if !ok {
+			return nil
+		}
+
+		edits := make([]edit, 0, len(r.TextEdits))
+		for _, e := range r.TextEdits {
+			if e.Pos < 0 || e.End > len(content) || e.End < e.Pos {
+				return nil
+			}
+
+			edits = append(edits, edit{
+				Start:   offsetToPosition(content, e.Pos),
+				End:     offsetToPosition(content, e.End),
+				NewText: e.NewText,
+			})
+		}
+
+		return edits
+	}
+
+	if r.Inline != nil {
+		return []edit{{
+			Start:   position{Line: issue.Line(), Column: r.Inline.StartCol + 1},
+			End:     position{Line: issue.Line(), Column: r.Inline.StartCol + r.Inline.Length + 1},
+			NewText: r.Inline.NewString,
+		}}
+	}
+
+	// Replaces the whole lines: the range ends at the start of the next line.
+	lineRange := issue.GetLineRange()
+
+	var text string
+	if !r.NeedOnlyDelete {
+		text = strings.Join(r.NewLines, "\n") + "\n"
+	}
+
+	return []edit{{
+		Start:   position{Line: lineRange.From, Column: 1},
+		End:     position{Line: lineRange.To + 1, Column: 1},
+		NewText: text,
+	}}
+}
+
+// utf16Column converts a 1-based column counted in bytes into a 1-based column counted in UTF-16 code units.
+// The column is unchanged if the line can't be read.
+func (f sourceFiles) utf16Column(path string, line, column int) int {
+	if column <= 1 {
+		return column
+	}
+
+	content, ok := f.read(path)
+	if !ok {
+		return column
+	}
+
+	lines := bytes.SplitN(content, []byte("\n"), line+1)
+	if line < 1 || line > len(lines) {
+		return column
+	}
+
+	text := lines[line-1]
+	if column-1 > len(text) {
+		return column
+	}
+
+	return len(utf16.Encode([]rune(string(text[:column-1])))) + 1
+}
+
+func offsetToPosition(content []byte, offset int) position {
+	before := content[:offset]
+
+	return position{
+		Line:   bytes.Count(before, []byte("\n")) + 1,
+		Column: offset - (bytes.LastIndexByte(before, '\n') + 1) + 1,
+	}
+}
@@ -8,8 +8,6 @@ import (
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
-const defaultGithubSeverity = "error"
-
 type GitHubAction struct {
 	w io.Writer
 }
@@ -32,10 +30,7 @@ func (p *GitHubAction) Print(issues []result.Issue) error {
 
 // print each line as: ::error file=app.js,line=10,col=15::Something went wrong
 func formatIssueAsGitHub(issue *result.Issue) string {
-	severity := defaultGithubSeverity
-	if issue.Severity != "" {
-		severity = issue.Severity
-	}
+	severity := githubSeverities.convert(issue.Severity)
 
 	// Convert backslashes to forward slashes.
 	// This is needed when running on windows.
@@ -0,0 +1,71 @@
+package printers
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// GitLabIssue is a GitLab Code Quality issue.
+// https://docs.gitlab.com/ee/ci/testing/code_quality.html#implement-a-custom-tool
+type GitLabIssue struct {
+	Description string         `json:"description"`
+	CheckName   string         `json:"check_name"`
+	Fingerprint string         `json:"fingerprint"`
+	Severity    string         `json:"severity"`
+	Location    GitLabLocation `json:"location"`
+}
+
+type GitLabLocation struct {
+	Path      string          `json:"path"`
+	Positions GitLabPositions `json:"positions"`
+}
+
+type GitLabPositions struct {
+	Begin GitLabPosition `json:"begin"`
+	End   GitLabPosition `json:"end"`
+}
+
+type GitLabPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column,omitempty"`
+}
+
+type GitLab struct {
+	w io.Writer
+}
+
+func NewGitLab(w io.Writer) *GitLab {
+	return &GitLab{w: w}
+}
+
+func (p GitLab) Print(issues []result.Issue) error {
+	gitlabIssues := make([]GitLabIssue, 0, len(issues))
+
+	for i := range issues {
+		issue := &issues[i]
+
+		gitlabIssue := GitLabIssue{
+			Description: issue.Description(),
+			CheckName:   issue.FromLinter,
+			Fingerprint: issue.StableFingerprint,
+			Severity:    codeClimateSeverities.convert(issue.Severity),
+			Location: GitLabLocation{
+				Path: issue.FilePath(),
+				Positions: GitLabPositions{
+					Begin: GitLabPosition{Line: issue.Line(), Column: issue.Column()},
+					End:   GitLabPosition{Line: issue.GetLineRange().To},
+				},
+			},
+		}
+
+		if gitlabIssue.Fingerprint == "" {
+			gitlabIssue.Fingerprint = issue.Fingerprint()
+		}
+
+		gitlabIssues = append(gitlabIssues, gitlabIssue)
+	}
+
+	return json.NewEncoder(p.w).Encode(gitlabIssues)
+}
@@ -0,0 +1,62 @@
+package printers
+
+import (
+	"bytes"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestGitLab_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "some issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter:        "linter-b",
+			Severity:          "low",
+			Text:              "another issue",
+			StableFingerprint: "0123456789ABCDEF0123456789ABCDEF",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   5,
+				Line:     300,
+				Column:   9,
+			},
+			LineRange: &result.Range{From: 300, To: 302},
+		},
+		{
+			FromLinter: "linter-c",
+			Text:       "issue without severity",
+			Pos: token.Position{
+				Filename: "path/to/filec.go",
+				Offset:   3,
+				Line:     11,
+			},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewGitLab(buf)
+
+	err := printer.Print(issues)
+	require.NoError(t, err)
+
+	expected := `[{"description":"linter-a: some issue","check_name":"linter-a","fingerprint":"BA73C5DF4A6FD8462FFF1D3140235777","severity":"major","location":{"path":"path/to/filea.go","positions":{"begin":{"line":10,"column":4},"end":{"line":10}}}},{"description":"linter-b: another issue","check_name":"linter-b","fingerprint":"0123456789ABCDEF0123456789ABCDEF","severity":"minor","location":{"path":"path/to/fileb.go","positions":{"begin":{"line":300,"column":9},"end":{"line":302}}}},{"description":"linter-c: issue without severity","check_name":"linter-c","fingerprint":"848C3F9C7F0D4BA96A04A646E593CD1F","severity":"critical","location":{"path":"path/to/filec.go","positions":{"begin":{"line":11},"end":{"line":11}}}}]
+`
+
+	assert.Equal(t, expected, buf.String())
+}
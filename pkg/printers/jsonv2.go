@@ -0,0 +1,167 @@
+package printers
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const (
+	jsonV2Version   = 2
+	jsonV2SchemaURI = "https://golangci-lint.run/jsonschema/json-v2.jsonschema.json"
+)
+
+// JSONV2 is a versioned JSON output: the contract is the JSON schema `jsonschema/json-v2.jsonschema.json`.
+type JSONV2 struct {
+	rd *report.Data
+	w  io.Writer
+}
+
+type JSONV2Output struct {
+	Schema  string         `json:"$schema"`
+	Version int            `json:"version"`
+	Tool    jsonV2Tool     `json:"tool"`
+	Run     jsonV2Run      `json:"run"`
+	Linters []jsonV2Linter `json:"linters"`
+	Issues  []jsonV2Issue  `json:"issues"`
+}
+
+type jsonV2Tool struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type jsonV2Run struct {
+	ConfigPath         string          `json:"configPath,omitempty"`
+	DurationMs         int64           `json:"durationMs"`
+	FingerprintVersion int             `json:"fingerprintVersion"`
+	Warnings           []jsonV2Warning `json:"warnings"`
+	Error              string          `json:"error,omitempty"`
+}
+
+type jsonV2Warning struct {
+	Tag  string `json:"tag,omitempty"`
+	Text string `json:"text"`
+}
+
+type jsonV2Linter struct {
+	Name         string `json:"name"`
+	SettingsHash string `json:"settingsHash,omitempty"`
+	DurationMs   int64  `json:"durationMs"`
+	Error        string `json:"error,omitempty"`
+}
+
+type jsonV2Issue struct {
+	Linter      string         `json:"linter"`
+	Message     string         `json:"message"`
+	Severity    string         `json:"severity,omitempty"`
+	Fingerprint string         `json:"fingerprint"`
+	Location    jsonV2Location `json:"location"`
+	SourceLines []string       `json:"sourceLines,omitempty"`
+	Fixes       []jsonV2Fix    `json:"fixes,omitempty"`
+}
+
+type jsonV2Location struct {
+	Path  string         `json:"path"`
+	Start jsonV2Position `json:"start"`
+	End   jsonV2Position `json:"end"`
+}
+
+// jsonV2Position is a 1-based position: the column is a count of bytes, 0 if unknown.
+type jsonV2Position struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+type jsonV2Fix struct {
+	Edits []jsonV2Edit `json:"edits"`
+}
+
+// jsonV2Edit replaces the text between start and end (exclusive) with newText.
+type jsonV2Edit struct {
+	Start   jsonV2Position `json:"start"`
+	End     jsonV2Position `json:"end"`
+	NewText string         `json:"newText"`
+}
+
+func NewJSONV2(rd *report.Data, w io.Writer) *JSONV2 {
+	return &JSONV2{rd: rd, w: w}
+}
+
+func (p *JSONV2) Print(issues []result.Issue) error {
+	output := JSONV2Output{
+		Schema:  jsonV2SchemaURI,
+		Version: jsonV2Version,
+		Tool:    jsonV2Tool{Name: "golangci-lint", Version: p.rd.Version},
+		Run: jsonV2Run{
+			ConfigPath:         p.rd.ConfigPath,
+			DurationMs:         p.rd.Duration.Milliseconds(),
+			FingerprintVersion: p.rd.FingerprintVersion,
+			Warnings:           make([]jsonV2Warning, 0, len(p.rd.Warnings)),
+			Error:              p.rd.Error,
+		},
+		Linters: []jsonV2Linter{},
+		Issues:  make([]jsonV2Issue, 0, len(issues)),
+	}
+
+	for _, w := range p.rd.Warnings {
+		output.Run.Warnings = append(output.Run.Warnings, jsonV2Warning{Tag: w.Tag, Text: w.Text})
+	}
+
+	for _, lnt := range p.rd.Linters {
+		if !lnt.Enabled {
+			continue
+		}
+
+		output.Linters = append(output.Linters, jsonV2Linter{
+			Name:         lnt.Name,
+			SettingsHash: lnt.SettingsHash,
+			DurationMs:   lnt.Duration.Milliseconds(),
+			Error:        lnt.Error,
+		})
+	}
+
+	files := sourceFiles{}
+
+	for i := range issues {
+		output.Issues = append(output.Issues, newJSONV2Issue(&issues[i], files))
+	}
+
+	return json.NewEncoder(p.w).Encode(output)
+}
+
+func newJSONV2Issue(issue *result.Issue, files sourceFiles) jsonV2Issue {
+	item := jsonV2Issue{
+		Linter:      issue.FromLinter,
+		Message:     issue.Text,
+		Severity:    issue.Severity,
+		Fingerprint: issue.StableFingerprint,
+		Location: jsonV2Location{
+			Path:  issue.FilePath(),
+			Start: jsonV2Position{Line: issue.Line(), Column: issue.Column()},
+			End:   jsonV2Position{Line: issue.GetLineRange().To},
+		},
+		SourceLines: issue.SourceLines,
+	}
+
+	if item.Fingerprint == "" {
+		item.Fingerprint = issue.Fingerprint()
+	}
+
+	if edits := toEdits(issue, files); len(edits) != 0 {
+		fix := jsonV2Fix{Edits: make([]jsonV2Edit, 0, len(edits))}
+		for _, e := range edits {
+			fix.Edits = append(fix.Edits, jsonV2Edit{
+				Start:   jsonV2Position(e.Start),
+				End:     jsonV2Position(e.End),
+				NewText: e.NewText,
+			})
+		}
+
+		item.Fixes = []jsonV2Fix{fix}
+	}
+
+	return item
+}
@@ -0,0 +1,130 @@
+package printers
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/token"
+	"testing"
+	"time"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestJSONV2_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter:        "linter-a",
+			Severity:          "warning",
+			Text:              "some issue",
+			StableFingerprint: "0123456789ABCDEF0123456789ABCDEF",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+			SourceLines: []string{"func foo() {"},
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 5, Length: 3, NewString: "bar"},
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Text:       "another issue",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   5,
+				Line:     300,
+				Column:   9,
+			},
+			LineRange: &result.Range{From: 300, To: 302},
+		},
+	}
+
+	rd := &report.Data{
+		Warnings: []report.Warning{{Tag: "runner", Text: "some warning"}},
+		Linters: []report.LinterData{
+			{Name: "linter-a", Enabled: true, SettingsHash: "abcdef", Duration: 1500 * time.Millisecond},
+			{Name: "linter-b", Enabled: true, Duration: 20 * time.Millisecond},
+			{Name: "linter-c"},
+		},
+		FingerprintVersion: 1,
+		Version:            "1.2.3",
+		ConfigPath:         ".golangci.yml",
+		Duration:           3 * time.Second,
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewJSONV2(rd, buf)
+
+	err := printer.Print(issues)
+	require.NoError(t, err)
+
+	expected := `{"$schema":"https://golangci-lint.run/jsonschema/json-v2.jsonschema.json","version":2,"tool":{"name":"golangci-lint","version":"1.2.3"},"run":{"configPath":".golangci.yml","durationMs":3000,"fingerprintVersion":1,"warnings":[{"tag":"runner","text":"some warning"}]},"linters":[{"name":"linter-a","settingsHash":"abcdef","durationMs":1500},{"name":"linter-b","durationMs":20}],"issues":[{"linter":"linter-a","message":"some issue","severity":"warning","fingerprint":"0123456789ABCDEF0123456789ABCDEF","location":{"path":"path/to/filea.go","start":{"line":10,"column":4},"end":{"line":10,"column":0}},"sourceLines":["func foo() {"],"fixes":[{"edits":[{"start":{"line":10,"column":6},"end":{"line":10,"column":9},"newText":"bar"}]}]},{"linter":"linter-b","message":"another issue","fingerprint":"B609EEED4447810FAD225099FBB1E825","location":{"path":"path/to/fileb.go","start":{"line":300,"column":9},"end":{"line":302,"column":0}}}]}
+`
+
+	assert.Equal(t, expected, buf.String())
+
+	validateJSONV2(t, buf.Bytes())
+}
+
+func TestJSONV2_Print_empty(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	printer := NewJSONV2(&report.Data{}, buf)
+
+	err := printer.Print(nil)
+	require.NoError(t, err)
+
+	expected := `{"$schema":"https://golangci-lint.run/jsonschema/json-v2.jsonschema.json","version":2,"tool":{"name":"golangci-lint"},"run":{"durationMs":0,"fingerprintVersion":0,"warnings":[]},"linters":[],"issues":[]}
+`
+
+	assert.Equal(t, expected, buf.String())
+
+	validateJSONV2(t, buf.Bytes())
+}
+
+func TestJSONV2_Print_failedLinter(t *testing.T) {
+	rd := &report.Data{
+		Linters: []report.LinterData{
+			{Name: "linter-a", Enabled: true, Duration: 20 * time.Millisecond},
+			{Name: "linter-b", Enabled: true, Error: "analysis failed"},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewJSONV2(rd, buf)
+
+	err := printer.Print(nil)
+	require.NoError(t, err)
+
+	expected := `{"$schema":"https://golangci-lint.run/jsonschema/json-v2.jsonschema.json","version":2,"tool":{"name":"golangci-lint"},"run":{"durationMs":0,"fingerprintVersion":0,"warnings":[]},"linters":[{"name":"linter-a","durationMs":20},{"name":"linter-b","durationMs":0,"error":"analysis failed"}],"issues":[]}
+`
+
+	assert.Equal(t, expected, buf.String())
+
+	validateJSONV2(t, buf.Bytes())
+}
+
+func validateJSONV2(t *testing.T, data []byte) {
+	t.Helper()
+
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft7
+
+	schema, err := compiler.Compile("../../jsonschema/json-v2.jsonschema.json")
+	require.NoError(t, err)
+
+	var v any
+	err = json.Unmarshal(data, &v)
+	require.NoError(t, err)
+
+	require.NoError(t, schema.Validate(v))
+}
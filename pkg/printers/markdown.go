@@ -0,0 +1,175 @@
+package printers
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"slices"
+	"strings"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const markdownNoSeverity = "none"
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"`", "\\`",
+	"*", `\*`,
+	"_", `\_`,
+	"[", `\[`,
+	"]", `\]`,
+	"<", `\<`,
+	">", `\>`,
+	"|", `\|`,
+)
+
+// Markdown prints a summary of the issues (PR comments, GitHub job summaries).
+type Markdown struct {
+	settings *config.MarkdownSettings
+	w        io.Writer
+}
+
+func NewMarkdown(settings *config.MarkdownSettings, w io.Writer) *Markdown {
+	return &Markdown{settings: settings, w: w}
+}
+
+func (p *Markdown) Print(issues []result.Issue) error {
+	b := &strings.Builder{}
+
+	b.WriteString("## golangci-lint\n\n")
+
+	if len(issues) == 0 {
+		b.WriteString("No issues.\n")
+
+		_, err := io.WriteString(p.w, b.String())
+		return err
+	}
+
+	fmt.Fprintf(b, "%d issue%s.\n\n", len(issues), plural(len(issues)))
+
+	linters := map[string]int{}
+	severities := map[string]int{}
+	files := map[string][]*result.Issue{}
+
+	for i := range issues {
+		issue := &issues[i]
+
+		linters[issue.FromLinter]++
+
+		severity := issue.Severity
+		if severity == "" {
+			severity = markdownNoSeverity
+		}
+		severities[severity]++
+
+		files[issue.FilePath()] = append(files[issue.FilePath()], issue)
+	}
+
+	writeMarkdownCountTable(b, "Linter", linters)
+	writeMarkdownCountTable(b, "Severity", severities)
+
+	paths := maps.Keys(files)
+	slices.Sort(paths)
+
+	for _, path := range paths {
+		fileIssues := files[path]
+
+		fmt.Fprintf(b, "<details>\n<summary><code>%s</code> (%d issue%s)</summary>\n\n",
+			html.EscapeString(path), len(fileIssues), plural(len(fileIssues)))
+
+		for _, issue := range fileIssues {
+			p.writeIssue(b, issue)
+		}
+
+		b.WriteString("</details>\n\n")
+	}
+
+	_, err := io.WriteString(p.w, b.String())
+	return err
+}
+
+func (p *Markdown) writeIssue(b *strings.Builder, issue *result.Issue) {
+	pos := fmt.Sprintf("%s:%d", issue.FilePath(), issue.Line())
+	if issue.Column() != 0 {
+		pos += fmt.Sprintf(":%d", issue.Column())
+	}
+
+	if link := p.permalink(issue); link != "" {
+		fmt.Fprintf(b, "- [%s](%s)", markdownEscaper.Replace(pos), link)
+	} else {
+		fmt.Fprintf(b, "- %s", markdownEscaper.Replace(pos))
+	}
+
+	fmt.Fprintf(b, ": %s (%s)\n", markdownEscaper.Replace(issue.Text), markdownEscaper.Replace(issue.FromLinter))
+
+	if len(issue.SourceLines) != 0 {
+		source := strings.Join(issue.SourceLines, "\n")
+		fence := markdownFence(source)
+
+		fmt.Fprintf(b, "\n  %sgo\n", fence)
+		for _, line := range issue.SourceLines {
+			fmt.Fprintf(b, "  %s\n", line)
+		}
+		fmt.Fprintf(b, "  %s\n\n", fence)
+	}
+}
+
+// permalink returns the URL of the lines of the issue (GitHub format: `<base URL>/<revision>/<path>#L<from>-L<to>`).
+func (p *Markdown) permalink(issue *result.Issue) string {
+	if p.settings == nil || p.settings.PermalinkBaseURL == "" || p.settings.Revision == "" {
+		return ""
+	}
+
+	link := fmt.Sprintf("%s/%s/%s#L%d", strings.TrimSuffix(p.settings.PermalinkBaseURL, "/"), p.settings.Revision,
+		strings.TrimPrefix(issue.FilePath(), "./"), issue.Line())
+
+	if lineRange := issue.GetLineRange(); lineRange.To > lineRange.From {
+		link += fmt.Sprintf("-L%d", lineRange.To)
+	}
+
+	return link
+}
+
+func writeMarkdownCountTable(b *strings.Builder, title string, counts map[string]int) {
+	keys := maps.Keys(counts)
+
+	// Sorted by decreasing count.
+	slices.SortFunc(keys, func(a, c string) int {
+		if counts[a] != counts[c] {
+			return counts[c] - counts[a]
+		}
+		return strings.Compare(a, c)
+	})
+
+	fmt.Fprintf(b, "| %s | Issues |\n|---|---:|\n", title)
+	for _, key := range keys {
+		fmt.Fprintf(b, "| %s | %d |\n", markdownEscaper.Replace(key), counts[key])
+	}
+	b.WriteString("\n")
+}
+
+// markdownFence returns a code fence longer than the backtick sequences of the code.
+func markdownFence(code string) string {
+	longest, current := 0, 0
+	for _, r := range code {
+		if r == '`' {
+			current++
+			longest = max(longest, current)
+		} else {
+			current = 0
+		}
+	}
+
+	return strings.Repeat("`", max(3, longest+1))
+}
+
+func plural(n int) string {
+	if n == 1 {
+		return ""
+	}
+	return "s"
+}
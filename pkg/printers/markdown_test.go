@@ -0,0 +1,119 @@
+package printers
+
+import (
+	"bytes"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestMarkdown_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "some issue with `code`",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+			SourceLines: []string{"\tfmt.Sprintf(\"a\")"},
+		},
+		{
+			FromLinter: "linter-b",
+			Text:       "another issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   5,
+				Line:     300,
+				Column:   9,
+			},
+			LineRange: &result.Range{From: 300, To: 302},
+		},
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "issue_2",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   3,
+				Line:     11,
+			},
+		},
+	}
+
+	testCases := []struct {
+		desc     string
+		settings *config.MarkdownSettings
+		expected string
+	}{
+		{
+			desc:     "without permalinks",
+			settings: &config.MarkdownSettings{},
+			expected: "## golangci-lint\n\n3 issues.\n\n" +
+				"| Linter | Issues |\n|---|---:|\n| linter-a | 2 |\n| linter-b | 1 |\n\n" +
+				"| Severity | Issues |\n|---|---:|\n| warning | 2 |\n| none | 1 |\n\n" +
+				"<details>\n<summary><code>path/to/filea.go</code> (1 issue)</summary>\n\n" +
+				"- path/to/filea.go:300:9: another issue (linter-b)\n" +
+				"</details>\n\n" +
+				"<details>\n<summary><code>path/to/fileb.go</code> (2 issues)</summary>\n\n" +
+				"- path/to/fileb.go:10:4: some issue with \\`code\\` (linter-a)\n\n" +
+				"  ```go\n  \tfmt.Sprintf(\"a\")\n  ```\n\n" +
+				"- path/to/fileb.go:11: issue\\_2 (linter-a)\n" +
+				"</details>\n\n",
+		},
+		{
+			desc:     "with permalinks",
+			settings: &config.MarkdownSettings{PermalinkBaseURL: "https://github.com/org/repo/blob/", Revision: "abc123"},
+			expected: "## golangci-lint\n\n3 issues.\n\n" +
+				"| Linter | Issues |\n|---|---:|\n| linter-a | 2 |\n| linter-b | 1 |\n\n" +
+				"| Severity | Issues |\n|---|---:|\n| warning | 2 |\n| none | 1 |\n\n" +
+				"<details>\n<summary><code>path/to/filea.go</code> (1 issue)</summary>\n\n" +
+				"- [path/to/filea.go:300:9](https://github.com/org/repo/blob/abc123/path/to/filea.go#L300-L302): another issue (linter-b)\n" +
+				"</details>\n\n" +
+				"<details>\n<summary><code>path/to/fileb.go</code> (2 issues)</summary>\n\n" +
+				"- [path/to/fileb.go:10:4](https://github.com/org/repo/blob/abc123/path/to/fileb.go#L10): some issue with \\`code\\` (linter-a)\n\n" +
+				"  ```go\n  \tfmt.Sprintf(\"a\")\n  ```\n\n" +
+				"- [path/to/fileb.go:11](https://github.com/org/repo/blob/abc123/path/to/fileb.go#L11): issue\\_2 (linter-a)\n" +
+				"</details>\n\n",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			buf := new(bytes.Buffer)
+
+			printer := NewMarkdown(test.settings, buf)
+
+			err := printer.Print(issues)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
+
+func TestMarkdown_Print_noIssues(t *testing.T) {
+	buf := new(bytes.Buffer)
+
+	printer := NewMarkdown(&config.MarkdownSettings{}, buf)
+
+	err := printer.Print(nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "## golangci-lint\n\nNo issues.\n", buf.String())
+}
+
+func Test_markdownFence(t *testing.T) {
+	assert.Equal(t, "```", markdownFence("a := 1"))
+	assert.Equal(t, "````", markdownFence("s := `a` + \"```\""))
+}
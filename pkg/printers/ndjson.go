@@ -0,0 +1,31 @@
+package printers
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// NDJSON prints one JSON object per issue and per line (the issues of the json-v2 format).
+// The issues can be printed while the linters are running (`output.stream`).
+type NDJSON struct {
+	files sourceFiles
+	w     io.Writer
+}
+
+func NewNDJSON(w io.Writer) *NDJSON {
+	return &NDJSON{files: sourceFiles{}, w: w}
+}
+
+func (p *NDJSON) Print(issues []result.Issue) error {
+	encoder := json.NewEncoder(p.w)
+
+	for i := range issues {
+		if err := encoder.Encode(newJSONV2Issue(&issues[i], p.files)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
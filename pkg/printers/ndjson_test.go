@@ -0,0 +1,58 @@
+package printers
+
+import (
+	"bytes"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestNDJSON_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter:        "linter-a",
+			Severity:          "warning",
+			Text:              "some issue",
+			StableFingerprint: "0123456789ABCDEF0123456789ABCDEF",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter:        "linter-b",
+			Text:              "another issue",
+			StableFingerprint: "FEDCBA9876543210FEDCBA9876543210",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   5,
+				Line:     300,
+				Column:   9,
+			},
+			LineRange: &result.Range{From: 300, To: 302},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewNDJSON(buf)
+
+	// The issues can be printed in several batches.
+	err := printer.Print(issues[:1])
+	require.NoError(t, err)
+
+	err = printer.Print(issues[1:])
+	require.NoError(t, err)
+
+	expected := `{"linter":"linter-a","message":"some issue","severity":"warning","fingerprint":"0123456789ABCDEF0123456789ABCDEF","location":{"path":"path/to/filea.go","start":{"line":10,"column":4},"end":{"line":10,"column":0}}}
+{"linter":"linter-b","message":"another issue","fingerprint":"FEDCBA9876543210FEDCBA9876543210","location":{"path":"path/to/fileb.go","start":{"line":300,"column":9},"end":{"line":302,"column":0}}}
+`
+
+	assert.Equal(t, expected, buf.String())
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"text/template"
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/logutils"
@@ -28,6 +29,14 @@ type Printer struct {
 
 	stdOut io.Writer
 	stdErr io.Writer
+
+	// The templates of the `template:<path>` formats by path:
+	// read before the creation of the outputs, a missing template doesn't leave an empty output.
+	templates map[string]*template.Template
+
+	// Created by the first call of Stream.
+	streams []issuePrinter
+	closers []io.Closer
 }
 
 // NewPrinter creates a new Printer.
@@ -42,12 +51,29 @@ func NewPrinter(log logutils.Log, cfg *config.Output, reportData *report.Data) (
 		return nil, errors.New("missing reportData argument in constructor")
 	}
 
+	templates := map[string]*template.Template{}
+
+	for _, format := range cfg.Formats {
+		templatePath, ok := format.TemplatePath()
+		if !ok || templates[templatePath] != nil {
+			continue
+		}
+
+		tmpl, err := parseTemplate(templatePath)
+		if err != nil {
+			return nil, err
+		}
+
+		templates[templatePath] = tmpl
+	}
+
 	return &Printer{
 		cfg:        cfg,
 		reportData: reportData,
 		log:        log,
 		stdOut:     logutils.StdOut,
 		stdErr:     logutils.StdErr,
+		templates:  templates,
 	}, nil
 }
 
@@ -63,6 +89,51 @@ func (c *Printer) Print(issues []result.Issue) error {
 	return nil
 }
 
+// Stream prints issues while the linters are running (`output.stream`).
+// The outputs are created by the first call and must be closed with Close.
+func (c *Printer) Stream(issues []result.Issue) error {
+	if c.streams == nil {
+		for _, format := range c.cfg.Formats {
+			w, shouldClose, err := c.createWriter(format.Path)
+			if err != nil {
+				return fmt.Errorf("can't create output for %s: %w", format.Path, err)
+			}
+
+			if file, ok := w.(io.Closer); shouldClose && ok {
+				c.closers = append(c.closers, file)
+			}
+
+			p, err := c.createPrinter(format, w)
+			if err != nil {
+				return err
+			}
+
+			c.streams = append(c.streams, p)
+		}
+	}
+
+	for _, p := range c.streams {
+		if err := p.Print(issues); err != nil {
+			return fmt.Errorf("can't print %d issues: %w", len(issues), err)
+		}
+	}
+
+	return nil
+}
+
+// Close closes the outputs created by Stream.
+func (c *Printer) Close() error {
+	var errs error
+	for _, closer := range c.closers {
+		errs = errors.Join(errs, closer.Close())
+	}
+
+	c.streams = nil
+	c.closers = nil
+
+	return errs
+}
+
 func (c *Printer) printReports(issues []result.Issue, format config.OutputFormat) error {
 	w, shouldClose, err := c.createWriter(format.Path)
 	if err != nil {
@@ -75,7 +146,7 @@ func (c *Printer) printReports(issues []result.Issue, format config.OutputFormat
 		}
 	}()
 
-	p, err := c.createPrinter(format.Format, w)
+	p, err := c.createPrinter(format, w)
 	if err != nil {
 		return err
 	}
@@ -109,12 +180,18 @@ func (c *Printer) createWriter(path string) (io.Writer, bool, error) {
 	return f, true, nil
 }
 
-func (c *Printer) createPrinter(format string, w io.Writer) (issuePrinter, error) {
+func (c *Printer) createPrinter(outputFormat config.OutputFormat, w io.Writer) (issuePrinter, error) {
+	if templatePath, ok := outputFormat.TemplatePath(); ok {
+		return NewTemplate(c.templates[templatePath], c.reportData, w), nil
+	}
+
 	var p issuePrinter
 
-	switch format {
+	switch format := outputFormat.Format; format {
 	case config.OutFormatJSON:
 		p = NewJSON(c.reportData, w)
+	case config.OutFormatJSONV2:
+		p = NewJSONV2(c.reportData, w)
 	case config.OutFormatLineNumber, config.OutFormatColoredLineNumber:
 		p = NewText(c.cfg.PrintIssuedLine,
 			format == config.OutFormatColoredLineNumber, c.cfg.PrintLinterName,
@@ -136,7 +213,15 @@ func (c *Printer) createPrinter(format string, w io.Writer) (issuePrinter, error
 	case config.OutFormatTeamCity:
 		p = NewTeamCity(w)
 	case config.OutFormatSarif:
-		p = NewSarif(w)
+		p = NewSarif(c.reportData, w)
+	case config.OutFormatRDJSON:
+		p = NewRDJSON(c.reportData, w)
+	case config.OutFormatGitLab:
+		p = NewGitLab(w)
+	case config.OutFormatMarkdown:
+		p = NewMarkdown(&c.cfg.Markdown, w)
+	case config.OutFormatNDJSON:
+		p = NewNDJSON(w)
 	default:
 		return nil, fmt.Errorf("unknown output format %q", format)
 	}
@@ -169,6 +169,26 @@ func TestPrinter_Print_file(t *testing.T) {
 	assert.Equal(t, string(golden), string(actual))
 }
 
+func TestNewPrinter_missingTemplate(t *testing.T) {
+	dir := t.TempDir()
+
+	outputPath := filepath.Join(dir, "report.txt")
+
+	cfg := &config.Output{
+		Formats: []config.OutputFormat{
+			{
+				Format: "template:" + filepath.Join(dir, "missing.tmpl"),
+				Path:   outputPath,
+			},
+		},
+	}
+
+	_, err := NewPrinter(logutils.NewStderrLog("skip"), cfg, &report.Data{})
+	require.ErrorContains(t, err, "can't read the template")
+
+	assert.NoFileExists(t, outputPath)
+}
+
 func TestPrinter_Print_multiple(t *testing.T) {
 	logger := logutils.NewStderrLog("skip")
 
@@ -227,3 +247,53 @@ func TestPrinter_Print_multiple(t *testing.T) {
 
 	assert.Equal(t, string(goldenJSON), stdOutBuffer.String())
 }
+
+func TestPrinter_Stream(t *testing.T) {
+	logger := logutils.NewStderrLog("skip")
+
+	var issues []result.Issue
+	unmarshalFile(t, "in-issues.json", &issues)
+
+	data := &report.Data{}
+	unmarshalFile(t, "in-report-data.json", data)
+
+	outputPath := filepath.Join(t.TempDir(), "report.txt")
+
+	cfg := &config.Output{
+		Formats: []config.OutputFormat{
+			{
+				Format: "line-number",
+				Path:   outputPath,
+			},
+			{
+				Format: "line-number",
+				Path:   "stderr",
+			},
+		},
+		Stream: true,
+	}
+
+	p, err := NewPrinter(logger, cfg, data)
+	require.NoError(t, err)
+
+	var stdErrBuffer bytes.Buffer
+	p.stdErr = &stdErrBuffer
+
+	// The file must not be truncated between the batches.
+	for i := range issues {
+		err = p.Stream(issues[i : i+1])
+		require.NoError(t, err)
+	}
+
+	err = p.Close()
+	require.NoError(t, err)
+
+	golden, err := os.ReadFile(filepath.Join("testdata", "golden-line-number.txt"))
+	require.NoError(t, err)
+
+	actual, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, string(golden), string(actual))
+	assert.Equal(t, string(golden), stdErrBuffer.String())
+}
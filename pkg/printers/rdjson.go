@@ -0,0 +1,126 @@
+package printers
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// RDJSON is the Reviewdog Diagnostic Format (JSON).
+// https://github.com/reviewdog/reviewdog/tree/master/proto/rdf
+type RDJSON struct {
+	rd *report.Data
+	w  io.Writer
+}
+
+type rdjsonResult struct {
+	Source      rdjsonSource       `json:"source"`
+	Diagnostics []rdjsonDiagnostic `json:"diagnostics"`
+}
+
+type rdjsonSource struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type rdjsonDiagnostic struct {
+	Message     string             `json:"message"`
+	Location    rdjsonLocation     `json:"location"`
+	Severity    string             `json:"severity,omitempty"`
+	Source      rdjsonSource       `json:"source"`
+	Code        rdjsonCode         `json:"code"`
+	Suggestions []rdjsonSuggestion `json:"suggestions,omitempty"`
+}
+
+type rdjsonCode struct {
+	Value string `json:"value"`
+	URL   string `json:"url,omitempty"`
+}
+
+type rdjsonLocation struct {
+	Path  string      `json:"path"`
+	Range rdjsonRange `json:"range"`
+}
+
+// rdjsonRange is a range of text: the end is exclusive.
+type rdjsonRange struct {
+	Start rdjsonPosition  `json:"start"`
+	End   *rdjsonPosition `json:"end,omitempty"`
+}
+
+// rdjsonPosition is a 1-based position: the column is a count of bytes.
+type rdjsonPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column,omitempty"`
+}
+
+type rdjsonSuggestion struct {
+	Range rdjsonRange `json:"range"`
+	Text  string      `json:"text"`
+}
+
+func NewRDJSON(rd *report.Data, w io.Writer) *RDJSON {
+	return &RDJSON{rd: rd, w: w}
+}
+
+func (p *RDJSON) Print(issues []result.Issue) error {
+	urls := map[string]string{}
+	for _, lnt := range p.rd.Linters {
+		urls[lnt.Name] = lnt.URL
+	}
+
+	files := sourceFiles{}
+
+	output := rdjsonResult{
+		Source:      rdjsonSource{Name: "golangci-lint", URL: "https://golangci-lint.run"},
+		Diagnostics: make([]rdjsonDiagnostic, 0, len(issues)),
+	}
+
+	for i := range issues {
+		issue := &issues[i]
+
+		diag := rdjsonDiagnostic{
+			Message: issue.Text,
+			Location: rdjsonLocation{
+				Path: issue.FilePath(),
+				Range: rdjsonRange{
+					Start: rdjsonPosition{Line: issue.Line(), Column: issue.Column()},
+				},
+			},
+			Severity: rdjsonSeverities.convert(issue.Severity),
+			Source:   rdjsonSource{Name: issue.FromLinter},
+			Code:     rdjsonCode{Value: issue.FromLinter, URL: urls[issue.FromLinter]},
+		}
+
+		if issue.LineRange != nil && issue.LineRange.To > issue.Line() {
+			diag.Location.Range.End = &rdjsonPosition{Line: issue.LineRange.To}
+		}
+
+		diag.Suggestions = newRDJSONSuggestions(issue, files)
+
+		output.Diagnostics = append(output.Diagnostics, diag)
+	}
+
+	return json.NewEncoder(p.w).Encode(output)
+}
+
+func newRDJSONSuggestions(issue *result.Issue, files sourceFiles) []rdjsonSuggestion {
+	edits := toEdits(issue, files)
+	if len(edits) == 0 {
+		return nil
+	}
+
+	suggestions := make([]rdjsonSuggestion, 0, len(edits))
+	for _, e := range edits {
+		end := rdjsonPosition(e.End)
+
+		suggestions = append(suggestions, rdjsonSuggestion{
+			Range: rdjsonRange{Start: rdjsonPosition(e.Start), End: &end},
+			Text:  e.NewText,
+		})
+	}
+
+	return suggestions
+}
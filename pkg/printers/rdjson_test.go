@@ -0,0 +1,129 @@
+package printers
+
+import (
+	"bytes"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestRDJSON_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "some issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 3, Length: 2, NewString: "foo"},
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Severity:   "error",
+			Text:       "another issue",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   5,
+				Line:     300,
+				Column:   9,
+			},
+			LineRange: &result.Range{From: 300, To: 302},
+			Replacement: &result.Replacement{
+				NewLines: []string{"bar", "baz"},
+			},
+		},
+		{
+			FromLinter: "linter-c",
+			Text:       "issue without severity",
+			Pos: token.Position{
+				Filename: "path/to/filec.go",
+				Offset:   3,
+				Line:     11,
+			},
+		},
+	}
+
+	rd := &report.Data{
+		Linters: []report.LinterData{{Name: "linter-a", URL: "https://example.com/linter-a"}},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewRDJSON(rd, buf)
+
+	err := printer.Print(issues)
+	require.NoError(t, err)
+
+	expected := `{"source":{"name":"golangci-lint","url":"https://golangci-lint.run"},"diagnostics":[{"message":"some issue","location":{"path":"path/to/filea.go","range":{"start":{"line":10,"column":4}}},"severity":"WARNING","source":{"name":"linter-a"},"code":{"value":"linter-a","url":"https://example.com/linter-a"},"suggestions":[{"range":{"start":{"line":10,"column":4},"end":{"line":10,"column":6}},"text":"foo"}]},{"message":"another issue","location":{"path":"path/to/fileb.go","range":{"start":{"line":300,"column":9},"end":{"line":302}}},"severity":"ERROR","source":{"name":"linter-b"},"code":{"value":"linter-b"},"suggestions":[{"range":{"start":{"line":300,"column":1},"end":{"line":303,"column":1}},"text":"bar\nbaz\n"}]},{"message":"issue without severity","location":{"path":"path/to/filec.go","range":{"start":{"line":11}}},"source":{"name":"linter-c"},"code":{"value":"linter-c"}}]}
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestRDJSON_Print_textEdits(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "a.go")
+
+	err := os.WriteFile(filename, []byte("package a\n\nvar x = fmt.Sprintf(\"%s\", s)\n"), 0o600)
+	require.NoError(t, err)
+
+	issues := []result.Issue{
+		{
+			FromLinter: "perfsprint",
+			Text:       "fmt.Sprintf can be replaced with just using the string",
+			Pos:        token.Position{Filename: filename, Line: 3, Column: 9},
+			Replacement: &result.Replacement{
+				TextEdits: []result.TextEdit{{Pos: 19, End: 39, NewText: "s"}},
+			},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewRDJSON(&report.Data{}, buf)
+
+	err = printer.Print(issues)
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), `"suggestions":[{"range":{"start":{"line":3,"column":9},"end":{"line":3,"column":29}},"text":"s"}]`)
+}
+
+func TestRDJSON_Print_textEdits_pathPrefix(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "a.go")
+
+	err := os.WriteFile(filename, []byte("package a\n\nvar x = fmt.Sprintf(\"%s\", s)\n"), 0o600)
+	require.NoError(t, err)
+
+	issues := []result.Issue{
+		{
+			FromLinter: "perfsprint",
+			Text:       "fmt.Sprintf can be replaced with just using the string",
+			Pos:        token.Position{Filename: filepath.Join("prefix", filename), Line: 3, Column: 9},
+			Replacement: &result.Replacement{
+				TextEdits: []result.TextEdit{{Pos: 19, End: 39, NewText: "s"}},
+			},
+			RealPath: filename,
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewRDJSON(&report.Data{}, buf)
+
+	err = printer.Print(issues)
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), `"suggestions":[{"range":{"start":{"line":3,"column":9},"end":{"line":3,"column":29}},"text":"s"}]`)
+}
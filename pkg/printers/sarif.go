@@ -2,14 +2,22 @@ package printers
 
 import (
 	"encoding/json"
+	"fmt"
 	"io"
 
+	"github.com/golangci/golangci-lint/pkg/report"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
 const (
 	sarifVersion   = "2.1.0"
 	sarifSchemaURI = "https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json"
+
+	sarifToolName           = "golangci-lint"
+	sarifToolInformationURI = "https://golangci-lint.run"
+
+	// The columns are converted from bytes to UTF-16 code units.
+	sarifColumnKind = "utf16CodeUnits"
 )
 
 type SarifOutput struct {
@@ -19,21 +27,52 @@ type SarifOutput struct {
 }
 
 type sarifRun struct {
-	Tool    sarifTool     `json:"tool"`
-	Results []sarifResult `json:"results"`
+	Tool        sarifTool         `json:"tool"`
+	ColumnKind  string            `json:"columnKind"`
+	Invocations []sarifInvocation `json:"invocations,omitempty"`
+	Results     []sarifResult     `json:"results"`
 }
 
 type sarifTool struct {
-	Driver struct {
-		Name string `json:"name"`
-	} `json:"driver"`
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri,omitempty"`
+	Rules          []sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID               string        `json:"id"`
+	ShortDescription *sarifMessage `json:"shortDescription,omitempty"`
+	HelpURI          string        `json:"helpUri,omitempty"`
+}
+
+type sarifInvocation struct {
+	ExecutionSuccessful        bool                `json:"executionSuccessful"`
+	ToolExecutionNotifications []sarifNotification `json:"toolExecutionNotifications,omitempty"`
+}
+
+type sarifNotification struct {
+	Level          string                   `json:"level"`
+	Message        sarifMessage             `json:"message"`
+	AssociatedRule *sarifReportingReference `json:"associatedRule,omitempty"`
+}
+
+type sarifReportingReference struct {
+	ID string `json:"id"`
 }
 
 type sarifResult struct {
-	RuleID    string          `json:"ruleId"`
-	Level     string          `json:"level"`
-	Message   sarifMessage    `json:"message"`
-	Locations []sarifLocation `json:"locations"`
+	RuleID              string            `json:"ruleId"`
+	RuleIndex           *int              `json:"ruleIndex,omitempty"`
+	Level               string            `json:"level"`
+	Message             sarifMessage      `json:"message"`
+	Locations           []sarifLocation   `json:"locations"`
+	PartialFingerprints map[string]string `json:"partialFingerprints,omitempty"`
+	Fixes               []sarifFix        `json:"fixes,omitempty"`
 }
 
 type sarifMessage struct {
@@ -54,35 +93,85 @@ type sarifArtifactLocation struct {
 	Index int    `json:"index"`
 }
 
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790936
 type sarifRegion struct {
-	StartLine   int `json:"startLine"`
-	StartColumn int `json:"startColumn"`
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
+}
+
+type sarifFix struct {
+	Description     sarifMessage          `json:"description"`
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion   `json:"deletedRegion"`
+	InsertedContent *sarifContent `json:"insertedContent,omitempty"`
+}
+
+type sarifContent struct {
+	Text string `json:"text"`
 }
 
 type Sarif struct {
-	w io.Writer
+	rd *report.Data
+	w  io.Writer
 }
 
-func NewSarif(w io.Writer) *Sarif {
-	return &Sarif{w: w}
+func NewSarif(rd *report.Data, w io.Writer) *Sarif {
+	return &Sarif{rd: rd, w: w}
 }
 
 func (p Sarif) Print(issues []result.Issue) error {
-	run := sarifRun{}
-	run.Tool.Driver.Name = "golangci-lint"
+	run := sarifRun{ColumnKind: sarifColumnKind}
+	run.Tool.Driver = sarifDriver{
+		Name:           sarifToolName,
+		Version:        p.rd.Version,
+		InformationURI: sarifToolInformationURI,
+	}
 	run.Results = make([]sarifResult, 0)
 
+	// Every enabled linter is a rule.
+	ruleIndexes := map[string]int{}
+	for _, lnt := range p.rd.Linters {
+		if !lnt.Enabled {
+			continue
+		}
+
+		ruleIndexes[lnt.Name] = len(run.Tool.Driver.Rules)
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, newSarifRule(lnt))
+	}
+
+	files := sourceFiles{}
+
 	for i := range issues {
 		issue := issues[i]
 
-		severity := issue.Severity
+		severity := sarifSeverities.convert(issue.Severity)
+
+		region := sarifRegion{
+			StartLine: issue.Line(),
+			// If startColumn is absent, it SHALL default to 1.
+			// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790941
+			StartColumn: max(1, files.utf16Column(issue.RealFilePath(), issue.Line(), issue.Column())),
+		}
+
+		// If endColumn is absent, the region ends at the end of the line.
+		switch {
+		case issue.LineRange != nil && issue.LineRange.To > issue.Line():
+			region.EndLine = issue.LineRange.To
 
-		switch severity {
-		// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790898
-		case "none", "note", "warning", "error":
-			// Valid levels.
-		default:
-			severity = "error"
+		case issue.Replacement != nil && issue.Replacement.Inline != nil:
+			// The inline fix replaces the columns of the issue.
+			inline := issue.Replacement.Inline
+			region.EndColumn = files.utf16Column(issue.RealFilePath(), issue.Line(), inline.StartCol+inline.Length+1)
 		}
 
 		sr := sarifResult{
@@ -93,20 +182,31 @@ func (p Sarif) Print(issues []result.Issue) error {
 				{
 					PhysicalLocation: sarifPhysicalLocation{
 						ArtifactLocation: sarifArtifactLocation{URI: issue.FilePath()},
-						Region: sarifRegion{
-							StartLine: issue.Line(),
-							// If startColumn is absent, it SHALL default to 1.
-							// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790941
-							StartColumn: max(1, issue.Column()),
-						},
+						Region:           region,
 					},
 				},
 			},
 		}
 
+		if index, ok := ruleIndexes[issue.FromLinter]; ok {
+			sr.RuleIndex = &index
+		}
+
+		if issue.StableFingerprint != "" {
+			sr.PartialFingerprints = map[string]string{
+				fmt.Sprintf("golangciLintFingerprint/v%d", result.StableFingerprintVersion): issue.StableFingerprint,
+			}
+		}
+
+		if fix := newSarifFix(&issue, files); fix != nil {
+			sr.Fixes = []sarifFix{*fix}
+		}
+
 		run.Results = append(run.Results, sr)
 	}
 
+	run.Invocations = []sarifInvocation{newSarifInvocation(p.rd)}
+
 	output := SarifOutput{
 		Version: sarifVersion,
 		Schema:  sarifSchemaURI,
@@ -115,3 +215,94 @@ func (p Sarif) Print(issues []result.Issue) error {
 
 	return json.NewEncoder(p.w).Encode(output)
 }
+
+func newSarifRule(lnt report.LinterData) sarifRule {
+	rule := sarifRule{
+		ID:      lnt.Name,
+		HelpURI: lnt.URL,
+	}
+
+	if lnt.Desc != "" {
+		rule.ShortDescription = &sarifMessage{Text: lnt.Desc}
+	}
+
+	return rule
+}
+
+// newSarifInvocation reports the errors and the warnings of the run, and the errors of the linters.
+func newSarifInvocation(rd *report.Data) sarifInvocation {
+	invocation := sarifInvocation{ExecutionSuccessful: rd.Error == ""}
+
+	for _, w := range rd.Warnings {
+		text := w.Text
+		if w.Tag != "" {
+			text = fmt.Sprintf("[%s] %s", w.Tag, w.Text)
+		}
+
+		invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications, sarifNotification{
+			Level:   "warning",
+			Message: sarifMessage{Text: text},
+		})
+	}
+
+	if rd.Error != "" {
+		invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications, sarifNotification{
+			Level:   "error",
+			Message: sarifMessage{Text: rd.Error},
+		})
+	}
+
+	for _, lnt := range rd.Linters {
+		if lnt.Error == "" {
+			continue
+		}
+
+		invocation.ExecutionSuccessful = false
+		invocation.ToolExecutionNotifications = append(invocation.ToolExecutionNotifications, sarifNotification{
+			Level:          "error",
+			Message:        sarifMessage{Text: fmt.Sprintf("Can't run linter %s: %s", lnt.Name, lnt.Error)},
+			AssociatedRule: &sarifReportingReference{ID: lnt.Name},
+		})
+	}
+
+	return invocation
+}
+
+// newSarifFix converts the replacement of the issue.
+func newSarifFix(issue *result.Issue, files sourceFiles) *sarifFix {
+	edits := toEdits(issue, files)
+	if len(edits) == 0 {
+		return nil
+	}
+
+	path := issue.RealFilePath()
+
+	replacements := make([]sarifReplacement, 0, len(edits))
+	for _, e := range edits {
+		replacements = append(replacements, sarifReplacement{
+			DeletedRegion: sarifRegion{
+				StartLine:   e.Start.Line,
+				StartColumn: files.utf16Column(path, e.Start.Line, e.Start.Column),
+				EndLine:     e.End.Line,
+				EndColumn:   files.utf16Column(path, e.End.Line, e.End.Column),
+			},
+			InsertedContent: newSarifContent(e.NewText),
+		})
+	}
+
+	return &sarifFix{
+		Description: sarifMessage{Text: fmt.Sprintf("Fix suggested by %s", issue.FromLinter)},
+		ArtifactChanges: []sarifArtifactChange{{
+			ArtifactLocation: sarifArtifactLocation{URI: issue.FilePath()},
+			Replacements:     replacements,
+		}},
+	}
+}
+
+func newSarifContent(text string) *sarifContent {
+	if text == "" {
+		return nil
+	}
+
+	return &sarifContent{Text: text}
+}
@@ -3,11 +3,14 @@ package printers
 import (
 	"bytes"
 	"go/token"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/golangci/golangci-lint/pkg/report"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
@@ -25,9 +28,10 @@ func TestSarif_Print(t *testing.T) {
 			},
 		},
 		{
-			FromLinter: "linter-b",
-			Severity:   "error",
-			Text:       "another issue",
+			FromLinter:        "linter-b",
+			Severity:          "error",
+			Text:              "another issue",
+			StableFingerprint: "0123456789ABCDEF0123456789ABCDEF",
 			SourceLines: []string{
 				"func foo() {",
 				"\tfmt.Println(\"bar\")",
@@ -65,12 +69,12 @@ func TestSarif_Print(t *testing.T) {
 
 	buf := new(bytes.Buffer)
 
-	printer := NewSarif(buf)
+	printer := NewSarif(&report.Data{}, buf)
 
 	err := printer.Print(issues)
 	require.NoError(t, err)
 
-	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint"}},"results":[{"ruleId":"linter-a","level":"warning","message":{"text":"some issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filea.go","index":0},"region":{"startLine":10,"startColumn":4}}}]},{"ruleId":"linter-b","level":"error","message":{"text":"another issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"region":{"startLine":300,"startColumn":9}}}]},{"ruleId":"linter-a","level":"error","message":{"text":"some issue 2"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filec.go","index":0},"region":{"startLine":11,"startColumn":5}}}]},{"ruleId":"linter-c","level":"error","message":{"text":"some issue without column"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filed.go","index":0},"region":{"startLine":11,"startColumn":1}}}]}]}]}
+	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint","informationUri":"https://golangci-lint.run"}},"columnKind":"utf16CodeUnits","invocations":[{"executionSuccessful":true}],"results":[{"ruleId":"linter-a","level":"warning","message":{"text":"some issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filea.go","index":0},"region":{"startLine":10,"startColumn":4}}}]},{"ruleId":"linter-b","level":"error","message":{"text":"another issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"region":{"startLine":300,"startColumn":9}}}],"partialFingerprints":{"golangciLintFingerprint/v2":"0123456789ABCDEF0123456789ABCDEF"}},{"ruleId":"linter-a","level":"note","message":{"text":"some issue 2"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filec.go","index":0},"region":{"startLine":11,"startColumn":5}}}]},{"ruleId":"linter-c","level":"error","message":{"text":"some issue without column"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filed.go","index":0},"region":{"startLine":11,"startColumn":1}}}]}]}]}
 `
 
 	assert.Equal(t, expected, buf.String())
@@ -79,13 +83,156 @@ func TestSarif_Print(t *testing.T) {
 func TestSarif_Print_empty(t *testing.T) {
 	buf := new(bytes.Buffer)
 
-	printer := NewSarif(buf)
+	printer := NewSarif(&report.Data{}, buf)
+
+	err := printer.Print(nil)
+	require.NoError(t, err)
+
+	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint","informationUri":"https://golangci-lint.run"}},"columnKind":"utf16CodeUnits","invocations":[{"executionSuccessful":true}],"results":[]}]}
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestSarif_Print_failedLinter(t *testing.T) {
+	rd := &report.Data{
+		Linters: []report.LinterData{
+			{Name: "linter-a", Enabled: true},
+			{Name: "linter-b", Enabled: true, Error: "analysis failed"},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewSarif(rd, buf)
 
 	err := printer.Print(nil)
 	require.NoError(t, err)
 
-	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint"}},"results":[]}]}
+	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint","informationUri":"https://golangci-lint.run","rules":[{"id":"linter-a"},{"id":"linter-b"}]}},"columnKind":"utf16CodeUnits","invocations":[{"executionSuccessful":false,"toolExecutionNotifications":[{"level":"error","message":{"text":"Can't run linter linter-b: analysis failed"},"associatedRule":{"id":"linter-b"}}]}],"results":[]}]}
 `
 
 	assert.Equal(t, expected, buf.String())
 }
+
+func TestSarif_Print_rulesAndFixes(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       "some issue",
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Line:     10,
+				Column:   4,
+			},
+			LineRange: &result.Range{From: 10, To: 12},
+			Replacement: &result.Replacement{
+				NewLines: []string{"foo"},
+			},
+		},
+		{
+			FromLinter: "linter-b",
+			Severity:   "error",
+			Text:       "another issue",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Line:     3,
+				Column:   2,
+			},
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 1, Length: 4, NewString: "bar"},
+			},
+		},
+		{
+			FromLinter: "linter-c",
+			Severity:   "error",
+			Text:       "issue from an unknown linter",
+			Pos: token.Position{
+				Filename: "path/to/filec.go",
+				Line:     5,
+			},
+			Replacement: &result.Replacement{NeedOnlyDelete: true},
+		},
+	}
+
+	rd := &report.Data{
+		Version: "1.2.3",
+		Linters: []report.LinterData{
+			{Name: "linter-a", Desc: "Linter A", URL: "https://example.com/linter-a", Enabled: true},
+			{Name: "linter-b", Enabled: true},
+			{Name: "linter-d", Desc: "Linter D"},
+		},
+		Warnings: []report.Warning{{Tag: "runner", Text: "Can't run linter linter-e"}},
+		Error:    "some error",
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewSarif(rd, buf)
+
+	err := printer.Print(issues)
+	require.NoError(t, err)
+
+	expected := `{"version":"2.1.0","$schema":"https://schemastore.azurewebsites.net/schemas/json/sarif-2.1.0-rtm.6.json","runs":[{"tool":{"driver":{"name":"golangci-lint","version":"1.2.3","informationUri":"https://golangci-lint.run","rules":[{"id":"linter-a","shortDescription":{"text":"Linter A"},"helpUri":"https://example.com/linter-a"},{"id":"linter-b"}]}},"columnKind":"utf16CodeUnits","invocations":[{"executionSuccessful":false,"toolExecutionNotifications":[{"level":"warning","message":{"text":"[runner] Can't run linter linter-e"}},{"level":"error","message":{"text":"some error"}}]}],"results":[{"ruleId":"linter-a","ruleIndex":0,"level":"warning","message":{"text":"some issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filea.go","index":0},"region":{"startLine":10,"startColumn":4,"endLine":12}}}],"fixes":[{"description":{"text":"Fix suggested by linter-a"},"artifactChanges":[{"artifactLocation":{"uri":"path/to/filea.go","index":0},"replacements":[{"deletedRegion":{"startLine":10,"startColumn":1,"endLine":13,"endColumn":1},"insertedContent":{"text":"foo\n"}}]}]}]},{"ruleId":"linter-b","ruleIndex":1,"level":"error","message":{"text":"another issue"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"region":{"startLine":3,"startColumn":2,"endColumn":6}}}],"fixes":[{"description":{"text":"Fix suggested by linter-b"},"artifactChanges":[{"artifactLocation":{"uri":"path/to/fileb.go","index":0},"replacements":[{"deletedRegion":{"startLine":3,"startColumn":2,"endLine":3,"endColumn":6},"insertedContent":{"text":"bar"}}]}]}]},{"ruleId":"linter-c","level":"error","message":{"text":"issue from an unknown linter"},"locations":[{"physicalLocation":{"artifactLocation":{"uri":"path/to/filec.go","index":0},"region":{"startLine":5,"startColumn":1}}}],"fixes":[{"description":{"text":"Fix suggested by linter-c"},"artifactChanges":[{"artifactLocation":{"uri":"path/to/filec.go","index":0},"replacements":[{"deletedRegion":{"startLine":5,"startColumn":1,"endLine":6,"endColumn":1}}]}]}]}]}]}
+`
+
+	assert.Equal(t, expected, buf.String())
+}
+
+func TestSarif_Print_textEdits(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "a.go")
+
+	err := os.WriteFile(filename, []byte("package a\n\nvar x = fmt.Sprintf(\"%s\", s)\n"), 0o600)
+	require.NoError(t, err)
+
+	issues := []result.Issue{
+		{
+			FromLinter: "perfsprint",
+			Text:       "fmt.Sprintf can be replaced with just using the string",
+			Pos:        token.Position{Filename: filename, Line: 3, Column: 9},
+			Replacement: &result.Replacement{
+				TextEdits: []result.TextEdit{{Pos: 19, End: 39, NewText: "s"}},
+			},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewSarif(&report.Data{}, buf)
+
+	err = printer.Print(issues)
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), `"replacements":[{"deletedRegion":{"startLine":3,"startColumn":9,"endLine":3,"endColumn":29},"insertedContent":{"text":"s"}}]`)
+}
+
+func TestSarif_Print_utf16Columns(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "a.go")
+
+	// "é" is 2 bytes and 1 UTF-16 code unit, "𝄞" is 4 bytes and 2 UTF-16 code units.
+	err := os.WriteFile(filename, []byte("package a\n\n// é𝄞 occured\n"), 0o600)
+	require.NoError(t, err)
+
+	issues := []result.Issue{
+		{
+			FromLinter: "misspell",
+			Text:       "`occured` is a misspelling of `occurred`",
+			Pos:        token.Position{Filename: filename, Line: 3, Column: 11},
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 10, Length: 7, NewString: "occurred"},
+			},
+		},
+	}
+
+	buf := new(bytes.Buffer)
+
+	printer := NewSarif(&report.Data{}, buf)
+
+	err = printer.Print(issues)
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), `"columnKind":"utf16CodeUnits"`)
+	assert.Contains(t, buf.String(), `"region":{"startLine":3,"startColumn":8,"endColumn":15}`)
+	assert.Contains(t, buf.String(), `"deletedRegion":{"startLine":3,"startColumn":8,"endLine":3,"endColumn":15}`)
+}
@@ -0,0 +1,98 @@
+package printers
+
+import (
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+)
+
+// severityMapping converts the severities of the issues into the severities of an output format.
+type severityMapping struct {
+	// The severities of the output format for the normalized severities.
+	error, warning, info, hint string
+
+	// none is the severity of the issues without severity or with an unknown severity.
+	none string
+
+	// native are the severities of the output format: these severities are kept as is.
+	native []string
+}
+
+func (m *severityMapping) convert(severity string) string {
+	for _, s := range m.native {
+		if strings.EqualFold(s, severity) {
+			return s
+		}
+	}
+
+	switch config.NormalizeSeverity(severity) {
+	case config.SeverityError:
+		return m.error
+	case config.SeverityWarning:
+		return m.warning
+	case config.SeverityInfo:
+		return m.info
+	case config.SeverityHint:
+		return m.hint
+	default:
+		return m.none
+	}
+}
+
+// https://checkstyle.sourceforge.io/property_types.html#SeverityLevel
+var checkstyleSeverities = severityMapping{
+	error:   "error",
+	warning: "warning",
+	info:    "info",
+	hint:    "info",
+	none:    "error",
+	native:  []string{"ignore", "info", "warning", "error"},
+}
+
+// https://github.com/codeclimate/platform/blob/master/spec/analyzers/SPEC.md#data-types
+// Also used by GitLab: https://docs.gitlab.com/ee/ci/testing/code_quality.html#implement-a-custom-tool
+var codeClimateSeverities = severityMapping{
+	error:   "critical",
+	warning: "major",
+	info:    "minor",
+	hint:    "info",
+	none:    "critical",
+	native:  []string{"minor", "major", "critical", "blocker"},
+}
+
+// https://docs.github.com/en/actions/using-workflows/workflow-commands-for-github-actions#setting-an-error-message
+var githubSeverities = severityMapping{
+	error:   "error",
+	warning: "warning",
+	info:    "notice",
+	hint:    "notice",
+	none:    "error",
+	native:  []string{"notice", "warning", "error"},
+}
+
+// https://github.com/reviewdog/reviewdog/blob/master/proto/rdf/reviewdog.proto
+var rdjsonSeverities = severityMapping{
+	error:   "ERROR",
+	warning: "WARNING",
+	info:    "INFO",
+	hint:    "INFO",
+}
+
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/errata01/os/sarif-v2.1.0-errata01-os-complete.html#_Toc141790898
+var sarifSeverities = severityMapping{
+	error:   "error",
+	warning: "warning",
+	info:    "note",
+	hint:    "note",
+	none:    "error",
+	native:  []string{"none", "note", "warning", "error"},
+}
+
+// https://www.jetbrains.com/help/teamcity/service-messages.html#Inspection+Instance
+var teamcitySeverities = severityMapping{
+	error:   "ERROR",
+	warning: "WARNING",
+	info:    "INFO",
+	hint:    "INFO",
+	native:  []string{"INFO", "WEAK WARNING", "WARNING", "ERROR"},
+}
@@ -0,0 +1,45 @@
+package printers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_severityMapping_convert(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		mapping  severityMapping
+		severity string
+		expected string
+	}{
+		{desc: "codeclimate: empty", mapping: codeClimateSeverities, severity: "", expected: "critical"},
+		{desc: "codeclimate: error", mapping: codeClimateSeverities, severity: "error", expected: "critical"},
+		{desc: "codeclimate: native", mapping: codeClimateSeverities, severity: "Blocker", expected: "blocker"},
+		{desc: "codeclimate: warning", mapping: codeClimateSeverities, severity: "warning", expected: "major"},
+		{desc: "codeclimate: low", mapping: codeClimateSeverities, severity: "low", expected: "minor"},
+		{desc: "codeclimate: hint", mapping: codeClimateSeverities, severity: "hint", expected: "info"},
+		{desc: "codeclimate: unknown", mapping: codeClimateSeverities, severity: "unknown", expected: "critical"},
+		{desc: "checkstyle: native", mapping: checkstyleSeverities, severity: "ignore", expected: "ignore"},
+		{desc: "checkstyle: high", mapping: checkstyleSeverities, severity: "high", expected: "error"},
+		{desc: "checkstyle: hint", mapping: checkstyleSeverities, severity: "hint", expected: "info"},
+		{desc: "github: info", mapping: githubSeverities, severity: "info", expected: "notice"},
+		{desc: "github: empty", mapping: githubSeverities, severity: "", expected: "error"},
+		{desc: "rdjson: medium", mapping: rdjsonSeverities, severity: "medium", expected: "WARNING"},
+		{desc: "rdjson: empty", mapping: rdjsonSeverities, severity: "", expected: ""},
+		{desc: "sarif: native", mapping: sarifSeverities, severity: "none", expected: "none"},
+		{desc: "sarif: info", mapping: sarifSeverities, severity: "info", expected: "note"},
+		{desc: "sarif: unknown", mapping: sarifSeverities, severity: "unknown", expected: "error"},
+		{desc: "teamcity: native", mapping: teamcitySeverities, severity: "weak warning", expected: "WEAK WARNING"},
+		{desc: "teamcity: critical", mapping: teamcitySeverities, severity: "critical", expected: "ERROR"},
+		{desc: "teamcity: empty", mapping: teamcitySeverities, severity: "", expected: ""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, test.mapping.convert(test.severity))
+		})
+	}
+}
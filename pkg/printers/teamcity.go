@@ -65,7 +65,7 @@ func (p *TeamCity) Print(issues []result.Issue) error {
 			message:  issue.Text,
 			file:     issue.FilePath(),
 			line:     issue.Line(),
-			severity: issue.Severity,
+			severity: teamcitySeverities.convert(issue.Severity),
 		}
 
 		_, err := instance.Print(p.w, p.escaper)
@@ -100,7 +100,7 @@ type InspectionInstance struct {
 	message  string // (optional)  limited by 4000 characters.
 	file     string // (mandatory) file path limited by 4000 characters.
 	line     int    // (optional)  line of the file.
-	severity string // (optional) any linter severity.
+	severity string // (optional) INFO, WEAK WARNING, WARNING, ERROR.
 }
 
 func (i InspectionInstance) Print(w io.Writer, replacer *strings.Replacer) (int, error) {
@@ -108,7 +108,7 @@ func (i InspectionInstance) Print(w io.Writer, replacer *strings.Replacer) (int,
 		cutVal(i.typeID, smallLimit),
 		cutVal(replacer.Replace(i.message), largeLimit),
 		cutVal(i.file, largeLimit),
-		i.line, strings.ToUpper(i.severity))
+		i.line, i.severity)
 }
 
 func cutVal(s string, limit int) string {
@@ -0,0 +1,112 @@
+package printers
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const templateNoSeverity = "none"
+
+// Template renders the issues with a Go template (text/template).
+type Template struct {
+	tmpl *template.Template
+	rd   *report.Data
+	w    io.Writer
+}
+
+// TemplateData is the data available inside the templates.
+type TemplateData struct {
+	Issues []result.Issue
+	Report *report.Data
+}
+
+func NewTemplate(tmpl *template.Template, rd *report.Data, w io.Writer) *Template {
+	return &Template{tmpl: tmpl, rd: rd, w: w}
+}
+
+// parseTemplate reads and parses the template.
+func parseTemplate(path string) (*template.Template, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("can't read the template: %w", err)
+	}
+
+	tmpl, err := template.New(filepath.Base(path)).Funcs(templateFuncs()).Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("can't parse the template: %w", err)
+	}
+
+	return tmpl, nil
+}
+
+func (p *Template) Print(issues []result.Issue) error {
+	data := TemplateData{
+		Issues: issues,
+		Report: p.rd,
+	}
+	if data.Issues == nil {
+		data.Issues = []result.Issue{}
+	}
+
+	return p.tmpl.Execute(p.w, data)
+}
+
+func templateFuncs() template.FuncMap {
+	return template.FuncMap{
+		// relPath returns the path relative to the working directory (slash-separated).
+		"relPath": func(path string) string {
+			rel, err := fsutils.ShortestRelPath(path, "")
+			if err != nil {
+				return filepath.ToSlash(path)
+			}
+
+			return filepath.ToSlash(rel)
+		},
+		// severity returns the severity of the issue, or "none".
+		"severity": func(issue result.Issue) string {
+			if issue.Severity == "" {
+				return templateNoSeverity
+			}
+
+			return issue.Severity
+		},
+		// fingerprint returns the stable fingerprint of the issue, or the legacy one.
+		"fingerprint": func(issue result.Issue) string {
+			if issue.StableFingerprint != "" {
+				return issue.StableFingerprint
+			}
+
+			return issue.Fingerprint()
+		},
+		// json returns the JSON encoding of the value.
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			if err != nil {
+				return "", err
+			}
+
+			return string(b), nil
+		},
+		// jsonEscape escapes the string to be used inside a JSON string (without the quotes).
+		"jsonEscape": func(s string) (string, error) {
+			b, err := json.Marshal(s)
+			if err != nil {
+				return "", err
+			}
+
+			return string(b[1 : len(b)-1]), nil
+		},
+		"join":  strings.Join,
+		"lower": strings.ToLower,
+		"upper": strings.ToUpper,
+	}
+}
@@ -0,0 +1,98 @@
+package printers
+
+import (
+	"bytes"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/report"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestTemplate_Print(t *testing.T) {
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Severity:   "warning",
+			Text:       `some "issue"`,
+			Pos: token.Position{
+				Filename: "path/to/filea.go",
+				Offset:   2,
+				Line:     10,
+				Column:   4,
+			},
+		},
+		{
+			FromLinter:        "linter-b",
+			Text:              "another issue",
+			StableFingerprint: "0123456789ABCDEF0123456789ABCDEF",
+			Pos: token.Position{
+				Filename: "path/to/fileb.go",
+				Offset:   5,
+				Line:     300,
+				Column:   9,
+			},
+		},
+	}
+
+	testCases := []struct {
+		desc     string
+		template string
+		expected string
+	}{
+		{
+			desc: "csv",
+			template: `{{ range .Issues }}{{ relPath .FilePath }},{{ .Line }},{{ .FromLinter }},{{ severity . }},{{ fingerprint . }}
+{{ end }}`,
+			expected: `path/to/filea.go,10,linter-a,warning,B7BEC03BAC9E3E2124ACCA0576EAA08B
+path/to/fileb.go,300,linter-b,none,0123456789ABCDEF0123456789ABCDEF
+`,
+		},
+		{
+			desc:     "json",
+			template: `{"version": {{ json .Report.Version }}, "text": "{{ range .Issues }}{{ jsonEscape .Text }};{{ end }}"}`,
+			expected: `{"version": "1.2.3", "text": "some \"issue\";another issue;"}`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "report.tmpl")
+
+			err := os.WriteFile(path, []byte(test.template), 0o600)
+			require.NoError(t, err)
+
+			buf := new(bytes.Buffer)
+
+			tmpl, err := parseTemplate(path)
+			require.NoError(t, err)
+
+			printer := NewTemplate(tmpl, &report.Data{Version: "1.2.3"}, buf)
+
+			err = printer.Print(issues)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, buf.String())
+		})
+	}
+}
+
+func Test_parseTemplate_error(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.tmpl")
+
+	err := os.WriteFile(path, []byte(`{{ range .Issues }}`), 0o600)
+	require.NoError(t, err)
+
+	_, err = parseTemplate(path)
+	require.ErrorContains(t, err, "can't parse the template")
+
+	_, err = parseTemplate(filepath.Join(t.TempDir(), "missing.tmpl"))
+	require.ErrorContains(t, err, "can't read the template")
+}
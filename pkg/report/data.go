@@ -1,5 +1,7 @@
 package report
 
+import "time"
+
 type Warning struct {
 	Tag  string `json:",omitempty"`
 	Text string
@@ -7,19 +9,40 @@ type Warning struct {
 
 type LinterData struct {
 	Name             string
-	Enabled          bool `json:",omitempty"`
-	EnabledByDefault bool `json:",omitempty"`
+	Desc             string `json:",omitempty"`
+	URL              string `json:",omitempty"`
+	Enabled          bool   `json:",omitempty"`
+	EnabledByDefault bool   `json:",omitempty"`
+
+	// Only for the enabled linters.
+	SettingsHash string        `json:",omitempty"`
+	Duration     time.Duration `json:",omitempty"`
+	Error        string        `json:",omitempty"`
 }
 
 type Data struct {
 	Warnings []Warning    `json:",omitempty"`
 	Linters  []LinterData `json:",omitempty"`
 	Error    string       `json:",omitempty"`
+
+	// Version of the algorithm of the issues' StableFingerprint.
+	FingerprintVersion int `json:",omitempty"`
+
+	// Version of golangci-lint.
+	Version string `json:",omitempty"`
+
+	// Path of the configuration file.
+	ConfigPath string `json:",omitempty"`
+
+	// Duration of the analysis.
+	Duration time.Duration `json:",omitempty"`
 }
 
-func (d *Data) AddLinter(name string, enabled, enabledByDefault bool) {
+func (d *Data) AddLinter(name, desc, url string, enabled, enabledByDefault bool) {
 	d.Linters = append(d.Linters, LinterData{
 		Name:             name,
+		Desc:             desc,
+		URL:              url,
 		Enabled:          enabled,
 		EnabledByDefault: enabledByDefault,
 	})
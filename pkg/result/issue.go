@@ -8,6 +8,11 @@ import (
 	"golang.org/x/tools/go/packages"
 )
 
+// StableFingerprintVersion is the version of the algorithm computing Issue.StableFingerprint.
+// It must be increased each time the algorithm changes.
+// The version 1 is the legacy Issue.Fingerprint.
+const StableFingerprintVersion = 2
+
 type Range struct {
 	From, To int
 }
@@ -16,6 +21,14 @@ type Replacement struct {
 	NeedOnlyDelete bool     // need to delete all lines of the issue without replacement with new lines
 	NewLines       []string // if NeedDelete is false it's the replacement lines
 	Inline         *InlineFix
+	TextEdits      []TextEdit `json:",omitempty"` // byte-offset based edits (e.g. from analyzers' suggested fixes), take precedence over other fields
+}
+
+// TextEdit replaces the bytes [Pos, End) of the file with NewText.
+type TextEdit struct {
+	Pos     int // zero-based byte offset
+	End     int // zero-based byte offset, equal to Pos for insertions
+	NewText string
 }
 
 type InlineFix struct {
@@ -49,12 +62,30 @@ type Issue struct {
 	// If we are expecting a nolint (because this is from nolintlint), record the expected linter
 	ExpectNoLint         bool
 	ExpectedNoLintLinter string
+
+	// StableFingerprint identifies the issue across line shifts, file renames and refactors (see StableFingerprintVersion).
+	StableFingerprint string `json:",omitempty"`
+
+	// Known is true if the issue is in the baseline or outside the diff: set only with `issues.fail-on-new-only`.
+	Known bool `json:",omitempty"`
+
+	// RealPath is the path of the file before the prefix of `output.path-prefix` (set only by the PathPrefixer).
+	RealPath string `json:"-"`
 }
 
 func (i *Issue) FilePath() string {
 	return i.Pos.Filename
 }
 
+// RealFilePath returns the path to read the file: the path without the prefix of `output.path-prefix`.
+func (i *Issue) RealFilePath() string {
+	if i.RealPath != "" {
+		return i.RealPath
+	}
+
+	return i.FilePath()
+}
+
 func (i *Issue) Line() int {
 	return i.Pos.Line
 }
@@ -0,0 +1,228 @@
+package processors
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const baselineVersion = 1
+
+var _ Processor = (*Baseline)(nil)
+
+type baselineFile struct {
+	Version int `json:"version"`
+	// Version of the algorithm of the fingerprints (result.StableFingerprintVersion).
+	FingerprintVersion int             `json:"fingerprintVersion"`
+	Issues             []baselineIssue `json:"issues"`
+}
+
+type baselineIssue struct {
+	Fingerprint string `json:"fingerprint"`
+	FromLinter  string `json:"linter"`
+	Path        string `json:"path"`
+	Text        string `json:"text"`
+	Count       int    `json:"count"`
+}
+
+// Baseline hides the issues recorded in the baseline file (or marks them as known with `issues.fail-on-new-only`),
+// or records the issues into the baseline file (`golangci-lint baseline create`).
+//
+// The issues are identified by their stable fingerprints (see Fingerprinter):
+// the baseline is tolerant of line shifts, file renames and reformatting.
+// The fingerprints are computed by the Fingerprinter: must be after it.
+type Baseline struct {
+	log logutils.Log
+
+	path   string
+	update bool
+
+	// keepKnown reports the issues of the baseline as known issues instead of hiding them.
+	keepKnown bool
+
+	// Baseline issues that haven't been matched yet.
+	remaining map[string]*baselineIssue
+}
+
+func NewBaseline(log logutils.Log, cfg *config.Issues) *Baseline {
+	return &Baseline{
+		log:       log,
+		path:      cfg.Baseline,
+		update:    cfg.NeedBaselineUpdate,
+		keepKnown: cfg.FailOnNewOnly,
+	}
+}
+
+func (*Baseline) Name() string {
+	return "baseline"
+}
+
+func (p *Baseline) Process(issues []result.Issue) ([]result.Issue, error) {
+	if p.path == "" {
+		return issues, nil
+	}
+
+	if p.update {
+		return issues, p.write(issues)
+	}
+
+	// The issues can be processed in several batches (`output.stream`).
+	if p.remaining == nil {
+		entries, err := p.read()
+		if err != nil {
+			return nil, err
+		}
+
+		p.remaining = make(map[string]*baselineIssue, len(entries))
+		for i := range entries {
+			p.remaining[entries[i].Fingerprint] = &entries[i]
+		}
+	}
+
+	return transformIssues(issues, func(issue *result.Issue) *result.Issue {
+		if issue.FromLinter == typeCheckName {
+			// Never hide typechecking errors.
+			return issue
+		}
+
+		entry, ok := p.remaining[issue.StableFingerprint]
+		if !ok || entry.Count == 0 {
+			return issue
+		}
+
+		entry.Count--
+
+		if !p.keepKnown {
+			return nil
+		}
+
+		knownIssue := *issue
+		knownIssue.Known = true
+
+		return &knownIssue
+	}), nil
+}
+
+func (p *Baseline) Finish() {
+	var stale []*baselineIssue
+	for _, entry := range p.remaining {
+		if entry.Count > 0 {
+			stale = append(stale, entry)
+		}
+	}
+
+	if len(stale) == 0 {
+		return
+	}
+
+	sort.Slice(stale, func(i, j int) bool {
+		return compareBaselineIssues(stale[i], stale[j])
+	})
+
+	p.log.Warnf("%d issues from the baseline %s no longer occur: update it with `golangci-lint baseline create`",
+		len(stale), p.path)
+
+	for _, entry := range stale {
+		p.log.Infof("No longer occurs: %s: %s: %s (%d)", entry.Path, entry.FromLinter, entry.Text, entry.Count)
+	}
+}
+
+func (p *Baseline) read() ([]baselineIssue, error) {
+	data, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("can't read baseline file: %w", err)
+	}
+
+	var bf baselineFile
+	if err = json.Unmarshal(data, &bf); err != nil {
+		return nil, fmt.Errorf("can't parse baseline file %s: %w", p.path, err)
+	}
+
+	if bf.Version != baselineVersion {
+		return nil, fmt.Errorf("unsupported baseline file version %d: recreate it with `golangci-lint baseline create`", bf.Version)
+	}
+
+	if bf.FingerprintVersion != result.StableFingerprintVersion {
+		return nil, fmt.Errorf("unsupported fingerprint version %d of the baseline file: recreate it with `golangci-lint baseline create`",
+			bf.FingerprintVersion)
+	}
+
+	return bf.Issues, nil
+}
+
+func (p *Baseline) write(issues []result.Issue) error {
+	entries := map[string]*baselineIssue{}
+
+	var count int
+	for i := range issues {
+		issue := &issues[i]
+		if issue.FromLinter == typeCheckName {
+			continue
+		}
+
+		count++
+
+		fingerprint := issue.StableFingerprint
+
+		if entry, ok := entries[fingerprint]; ok {
+			entry.Count++
+			continue
+		}
+
+		entries[fingerprint] = &baselineIssue{
+			Fingerprint: fingerprint,
+			FromLinter:  issue.FromLinter,
+			Path:        filepath.ToSlash(issue.FilePath()),
+			Text:        issue.Text,
+			Count:       1,
+		}
+	}
+
+	bf := baselineFile{
+		Version:            baselineVersion,
+		FingerprintVersion: result.StableFingerprintVersion,
+		Issues:             make([]baselineIssue, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		bf.Issues = append(bf.Issues, *entry)
+	}
+
+	sort.Slice(bf.Issues, func(i, j int) bool {
+		return compareBaselineIssues(&bf.Issues[i], &bf.Issues[j])
+	})
+
+	data, err := json.MarshalIndent(bf, "", "  ")
+	if err != nil {
+		return fmt.Errorf("can't marshal baseline: %w", err)
+	}
+
+	if err = os.WriteFile(p.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("can't write baseline file: %w", err)
+	}
+
+	p.log.Infof("Recorded %d issues into the baseline %s", count, p.path)
+
+	return nil
+}
+
+func compareBaselineIssues(a, b *baselineIssue) bool {
+	if a.Path != b.Path {
+		return a.Path < b.Path
+	}
+
+	if a.FromLinter != b.FromLinter {
+		return a.FromLinter < b.FromLinter
+	}
+
+	if a.Text != b.Text {
+		return a.Text < b.Text
+	}
+
+	return a.Fingerprint < b.Fingerprint
+}
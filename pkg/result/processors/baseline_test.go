@@ -0,0 +1,158 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestBaseline(t *testing.T) {
+	dir := t.TempDir()
+
+	baselinePath := filepath.Join(dir, "baseline.json")
+
+	newIssue := func(line int, text, fingerprint string) result.Issue {
+		return result.Issue{
+			FromLinter:        "linter",
+			Text:              text,
+			Pos:               token.Position{Filename: "a.go", Line: line},
+			StableFingerprint: fingerprint,
+		}
+	}
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+
+	create := NewBaseline(log, &config.Issues{Baseline: baselinePath, NeedBaselineUpdate: true})
+
+	recorded := []result.Issue{newIssue(3, "a", "fa"), newIssue(5, "b", "fb")}
+
+	issues, err := create.Process(recorded)
+	require.NoError(t, err)
+
+	// the issues are not hidden while creating the baseline.
+	assert.Equal(t, recorded, issues)
+
+	p := NewBaseline(log, &config.Issues{Baseline: baselinePath})
+
+	// lines shifted (same fingerprint) and the code of the "b" issue changed (new fingerprint).
+	issues, err = p.Process([]result.Issue{
+		newIssue(4, "a", "fa"),
+		newIssue(6, "b", "fc"),
+		{FromLinter: typeCheckName, Text: "a", Pos: token.Position{Filename: "a.go", Line: 4}, StableFingerprint: "fa"},
+	})
+	require.NoError(t, err)
+
+	expected := []result.Issue{
+		newIssue(6, "b", "fc"),
+		{FromLinter: typeCheckName, Text: "a", Pos: token.Position{Filename: "a.go", Line: 4}, StableFingerprint: "fa"},
+	}
+
+	assert.Equal(t, expected, issues)
+
+	var stale []string
+	for _, entry := range p.remaining {
+		if entry.Count > 0 {
+			stale = append(stale, entry.Text)
+		}
+	}
+
+	assert.Equal(t, []string{"b"}, stale)
+}
+
+func TestBaseline_batches(t *testing.T) {
+	dir := t.TempDir()
+
+	baselinePath := filepath.Join(dir, "baseline.json")
+
+	issue := result.Issue{
+		FromLinter:        "linter",
+		Text:              "a",
+		Pos:               token.Position{Filename: "a.go", Line: 3},
+		StableFingerprint: "fa",
+	}
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+
+	create := NewBaseline(log, &config.Issues{Baseline: baselinePath, NeedBaselineUpdate: true})
+
+	_, err := create.Process([]result.Issue{issue})
+	require.NoError(t, err)
+
+	p := NewBaseline(log, &config.Issues{Baseline: baselinePath})
+
+	issues, err := p.Process([]result.Issue{issue})
+	require.NoError(t, err)
+
+	assert.Empty(t, issues)
+
+	// The baseline entry is already used by the previous batch (`output.stream`).
+	issues, err = p.Process([]result.Issue{issue})
+	require.NoError(t, err)
+
+	assert.Equal(t, []result.Issue{issue}, issues)
+}
+
+func TestBaseline_failOnNewOnly(t *testing.T) {
+	dir := t.TempDir()
+
+	baselinePath := filepath.Join(dir, "baseline.json")
+
+	recorded := result.Issue{
+		FromLinter:        "linter",
+		Text:              "a",
+		Pos:               token.Position{Filename: "a.go", Line: 3},
+		StableFingerprint: "fa",
+	}
+
+	log := logutils.NewStderrLog(logutils.DebugKeyEmpty)
+
+	create := NewBaseline(log, &config.Issues{Baseline: baselinePath, NeedBaselineUpdate: true})
+
+	_, err := create.Process([]result.Issue{recorded})
+	require.NoError(t, err)
+
+	p := NewBaseline(log, &config.Issues{Baseline: baselinePath, FailOnNewOnly: true})
+
+	newIssue := result.Issue{
+		FromLinter:        "linter",
+		Text:              "b",
+		Pos:               token.Position{Filename: "a.go", Line: 5},
+		StableFingerprint: "fb",
+	}
+
+	issues, err := p.Process([]result.Issue{recorded, newIssue})
+	require.NoError(t, err)
+
+	knownIssue := recorded
+	knownIssue.Known = true
+
+	// The issues of the baseline are reported as known issues.
+	assert.Equal(t, []result.Issue{knownIssue, newIssue}, issues)
+}
+
+func TestBaseline_fingerprintVersion(t *testing.T) {
+	baselinePath := filepath.Join(t.TempDir(), "baseline.json")
+
+	err := os.WriteFile(baselinePath, []byte(`{"version": 1, "fingerprintVersion": 1, "issues": []}`), 0o600)
+	require.NoError(t, err)
+
+	p := NewBaseline(logutils.NewStderrLog(logutils.DebugKeyEmpty), &config.Issues{Baseline: baselinePath})
+
+	_, err = p.Process([]result.Issue{{FromLinter: "linter", Text: "a", StableFingerprint: "fa"}})
+	require.EqualError(t, err,
+		"unsupported fingerprint version 1 of the baseline file: recreate it with `golangci-lint baseline create`")
+}
+
+func TestBaseline_disabled(t *testing.T) {
+	p := NewBaseline(logutils.NewStderrLog(logutils.DebugKeyEmpty), &config.Issues{})
+
+	processAssertSame(t, p, result.Issue{FromLinter: "linter", Text: "a"})
+}
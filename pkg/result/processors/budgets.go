@@ -0,0 +1,170 @@
+package processors
+
+import (
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+var _ Processor = (*Budgets)(nil)
+
+type budget struct {
+	cfg config.Budget
+
+	path *regexp.Regexp
+
+	count int
+}
+
+func (b *budget) match(issue *result.Issue, path string) bool {
+	if len(b.cfg.Linters) != 0 && !slices.Contains(b.cfg.Linters, issue.FromLinter) {
+		return false
+	}
+
+	return b.path == nil || b.path.MatchString(filepath.ToSlash(path))
+}
+
+func (b *budget) String() string {
+	var conditions []string
+
+	if len(b.cfg.Linters) != 0 {
+		conditions = append(conditions, "linters: "+strings.Join(b.cfg.Linters, ", "))
+	}
+
+	if b.cfg.Path != "" {
+		conditions = append(conditions, "path: "+b.cfg.Path)
+	}
+
+	return strings.Join(conditions, "; ")
+}
+
+// Budgets counts the issues per budget (`issues.budgets`).
+// The issues are counted before the processors limiting the number of issues.
+type Budgets struct {
+	log   logutils.Log
+	files *fsutils.Files
+
+	budgets []*budget
+}
+
+func NewBudgets(log logutils.Log, files *fsutils.Files, cfg *config.Issues) *Budgets {
+	p := &Budgets{log: log, files: files}
+
+	for _, b := range cfg.Budgets {
+		item := &budget{cfg: b}
+
+		if b.Path != "" {
+			item.path = globToRegexp(b.Path)
+		}
+
+		p.budgets = append(p.budgets, item)
+	}
+
+	return p
+}
+
+func (*Budgets) Name() string {
+	return "budgets"
+}
+
+func (p *Budgets) Process(issues []result.Issue) ([]result.Issue, error) {
+	if len(p.budgets) == 0 {
+		return issues, nil
+	}
+
+	for i := range issues {
+		// The paths are compared with the path prefix: the same way as the paths displayed to the user.
+		path := p.files.WithPathPrefix(issues[i].FilePath())
+
+		for _, b := range p.budgets {
+			if b.match(&issues[i], path) {
+				b.count++
+			}
+		}
+	}
+
+	return issues, nil
+}
+
+func (p *Budgets) Finish() {
+	for i, b := range p.budgets {
+		if b.count > b.cfg.Max {
+			p.log.Warnf("Budget #%d (%s) exceeded: %d issues, max %d", i, b, b.count, b.cfg.Max)
+			continue
+		}
+
+		if b.count < b.cfg.Max {
+			p.log.Infof("Budget #%d (%s) can be lowered: %d issues, max %d", i, b, b.count, b.cfg.Max)
+		}
+	}
+}
+
+// Counts returns the number of issues per budget, in the order of the configuration.
+func (p *Budgets) Counts() []int {
+	counts := make([]int, 0, len(p.budgets))
+	for _, b := range p.budgets {
+		counts = append(counts, b.count)
+	}
+
+	return counts
+}
+
+// Tolerates returns true if the issue is inside at least one budget and none of its budgets is exceeded:
+// the issue doesn't fail the run.
+// The path of the issue already contains the path prefix.
+func (p *Budgets) Tolerates(issue *result.Issue) bool {
+	matched := false
+
+	for _, b := range p.budgets {
+		if !b.match(issue, issue.FilePath()) {
+			continue
+		}
+
+		if b.count > b.cfg.Max {
+			return false
+		}
+
+		matched = true
+	}
+
+	return matched
+}
+
+// globToRegexp converts a glob: `**` matches any sequence of characters, `*` any sequence of characters except `/`,
+// and `?` any character except `/`.
+func globToRegexp(glob string) *regexp.Regexp {
+	var buf strings.Builder
+	buf.WriteString("^")
+
+	for i := 0; i < len(glob); i++ {
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			// Any directories, including none.
+			buf.WriteString("(.*/)?")
+			i += 2
+
+		case strings.HasPrefix(glob[i:], "**"):
+			buf.WriteString(".*")
+			i++
+
+		case glob[i] == '*':
+			buf.WriteString("[^/]*")
+
+		case glob[i] == '?':
+			buf.WriteString("[^/]")
+
+		default:
+			buf.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+
+	buf.WriteString("$")
+
+	return regexp.MustCompile(buf.String())
+}
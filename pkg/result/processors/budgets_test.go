@@ -0,0 +1,99 @@
+package processors
+
+import (
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestBudgets(t *testing.T) {
+	cfg := &config.Issues{
+		Budgets: []config.Budget{
+			{Linters: []string{"linter-a"}, Path: "legacy/**", Max: 2},
+			{Path: "legacy/old/*.go", Max: 1},
+			{Linters: []string{"linter-b"}, Max: 5},
+		},
+	}
+
+	p := NewBudgets(logutils.NewStderrLog(logutils.DebugKeyEmpty), fsutils.NewFiles(nil, ""), cfg)
+
+	newIssue := func(linter, path string) result.Issue {
+		return result.Issue{FromLinter: linter, Pos: token.Position{Filename: path}}
+	}
+
+	issues := []result.Issue{
+		newIssue("linter-a", "legacy/a.go"),
+		newIssue("linter-a", "legacy/old/b.go"),
+		newIssue("linter-c", "legacy/old/c.go"),
+		newIssue("linter-b", "legacy/d.go"),
+		newIssue("linter-c", "e.go"),
+	}
+
+	processed, err := p.Process(issues)
+	require.NoError(t, err)
+
+	// The issues are only counted.
+	assert.Equal(t, issues, processed)
+
+	assert.Equal(t, []int{2, 2, 1}, p.Counts())
+
+	// Within the first budget.
+	assert.True(t, p.Tolerates(&issues[0]))
+	// Within the first budget, but the second budget is exceeded.
+	assert.False(t, p.Tolerates(&issues[1]))
+	// The second budget is exceeded.
+	assert.False(t, p.Tolerates(&issues[2]))
+	// Within the third budget.
+	assert.True(t, p.Tolerates(&issues[3]))
+	// Outside the budgets.
+	assert.False(t, p.Tolerates(&issues[4]))
+}
+
+func TestBudgets_pathPrefix(t *testing.T) {
+	cfg := &config.Issues{
+		Budgets: []config.Budget{{Path: "prefix/legacy/*.go", Max: 1}},
+	}
+
+	p := NewBudgets(logutils.NewStderrLog(logutils.DebugKeyEmpty), fsutils.NewFiles(nil, "prefix"), cfg)
+
+	_, err := p.Process([]result.Issue{{FromLinter: "linter-a", Pos: token.Position{Filename: "legacy/a.go"}}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{1}, p.Counts())
+
+	// The final issues contain the path prefix.
+	assert.True(t, p.Tolerates(&result.Issue{FromLinter: "linter-a", Pos: token.Position{Filename: "prefix/legacy/a.go"}}))
+}
+
+func Test_globToRegexp(t *testing.T) {
+	testCases := []struct {
+		glob     string
+		path     string
+		expected bool
+	}{
+		{glob: "pkg/*.go", path: "pkg/a.go", expected: true},
+		{glob: "pkg/*.go", path: "pkg/sub/a.go", expected: false},
+		{glob: "pkg/**", path: "pkg/sub/a.go", expected: true},
+		{glob: "pkg/**", path: "other/a.go", expected: false},
+		{glob: "**/legacy/*.go", path: "legacy/a.go", expected: true},
+		{glob: "**/legacy/*.go", path: "pkg/legacy/a.go", expected: true},
+		{glob: "**/legacy/*.go", path: "pkg/legacy_test/a.go", expected: false},
+		{glob: "pkg/a?.go", path: "pkg/ab.go", expected: true},
+		{glob: "pkg/a.go", path: "pkg/abgo", expected: false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.glob+" "+test.path, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, globToRegexp(test.glob).MatchString(test.path))
+		})
+	}
+}
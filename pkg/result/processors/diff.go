@@ -23,6 +23,9 @@ type Diff struct {
 	patchFilePath string
 	wholeFiles    bool
 	patch         string
+
+	// keepKnown reports the issues outside the diff as known issues instead of hiding them.
+	keepKnown bool
 }
 
 func NewDiff(cfg *config.Issues) *Diff {
@@ -32,6 +35,7 @@ func NewDiff(cfg *config.Issues) *Diff {
 		patchFilePath: cfg.DiffPatchFilePath,
 		wholeFiles:    cfg.WholeFiles,
 		patch:         os.Getenv(envGolangciDiffProcessorPatch),
+		keepKnown:     cfg.FailOnNewOnly,
 	}
 }
 
@@ -72,7 +76,13 @@ func (p Diff) Process(issues []result.Issue) ([]result.Issue, error) {
 
 		hunkPos, isNew := c.IsNewIssue(issue)
 		if !isNew {
-			return nil
+			if !p.keepKnown {
+				return nil
+			}
+
+			knownIssue := *issue
+			knownIssue.Known = true
+			return &knownIssue
 		}
 
 		newIssue := *issue
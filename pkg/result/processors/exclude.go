@@ -15,10 +15,17 @@ type Exclude struct {
 	name string
 
 	pattern *regexp.Regexp
+
+	// The patterns are only used to count the issues matched by each pattern (`issues.report-unused-exclusions`).
+	patterns []*regexp.Regexp
+	keys     []string
+	unused   *UnusedExclusions
 }
 
-func NewExclude(cfg *config.Issues) *Exclude {
-	p := &Exclude{name: "exclude"}
+// NewExclude creates the processor of the exclude patterns (`issues.exclude`).
+// The positions of the patterns in the configuration files are used to report the unused patterns (can be nil).
+func NewExclude(cfg *config.Issues, unused *UnusedExclusions, positions []string) *Exclude {
+	p := &Exclude{name: "exclude", unused: unused}
 
 	var pattern string
 	if len(cfg.ExcludePatterns) != 0 {
@@ -35,6 +42,13 @@ func NewExclude(cfg *config.Issues) *Exclude {
 		p.pattern = regexp.MustCompile(prefix + pattern)
 	}
 
+	if unused.isEnabled() {
+		for i, pattern := range cfg.ExcludePatterns {
+			p.patterns = append(p.patterns, regexp.MustCompile(prefix+pattern))
+			p.keys = append(p.keys, unused.register("exclude pattern", "issues.exclude", positions, i, pattern))
+		}
+	}
+
 	return p
 }
 
@@ -48,7 +62,17 @@ func (p Exclude) Process(issues []result.Issue) ([]result.Issue, error) {
 	}
 
 	return filterIssues(issues, func(issue *result.Issue) bool {
-		return !p.pattern.MatchString(issue.Text)
+		if !p.pattern.MatchString(issue.Text) {
+			return true
+		}
+
+		for i, pattern := range p.patterns {
+			if pattern.MatchString(issue.Text) {
+				p.unused.match(p.keys[i])
+			}
+		}
+
+		return false
 	}), nil
 }
 
@@ -22,13 +22,31 @@ type ExcludeRules struct {
 	files *fsutils.Files
 
 	rules []excludeRule
+
+	// The keys of the rules of the configuration (`issues.report-unused-exclusions`): the default rules are not reported.
+	keys   []string
+	unused *UnusedExclusions
 }
 
-func NewExcludeRules(log logutils.Log, files *fsutils.Files, cfg *config.Issues) *ExcludeRules {
+// NewExcludeRules creates the processor of the exclude rules (`issues.exclude-rules`).
+// The positions of the rules in the configuration files are used to report the unused and the expired rules (can be nil).
+func NewExcludeRules(log logutils.Log, files *fsutils.Files, cfg *config.Issues,
+	unused *UnusedExclusions, expired *ExpiredExcludeRules, positions []string,
+) *ExcludeRules {
 	p := &ExcludeRules{
-		name:  "exclude-rules",
-		files: files,
-		log:   log,
+		name:   "exclude-rules",
+		files:  files,
+		log:    log,
+		unused: unused,
+	}
+
+	expired.register(cfg.ExcludeRules, positions)
+
+	if unused.isEnabled() {
+		for i := range cfg.ExcludeRules {
+			p.keys = append(p.keys,
+				unused.register("exclude rule", "issues.exclude-rules", positions, i, describeRule(&cfg.ExcludeRules[i].BaseRule)))
+		}
 	}
 
 	prefix := caseInsensitivePrefix
@@ -62,6 +80,10 @@ func (p ExcludeRules) Process(issues []result.Issue) ([]result.Issue, error) {
 		return issues, nil
 	}
 
+	if p.unused.isEnabled() {
+		return filterIssues(issues, p.countMatches), nil
+	}
+
 	return filterIssues(issues, func(issue *result.Issue) bool {
 		for _, rule := range p.rules {
 			if rule.match(issue, p.files, p.log) {
@@ -73,6 +95,25 @@ func (p ExcludeRules) Process(issues []result.Issue) ([]result.Issue, error) {
 	}), nil
 }
 
+// countMatches is like the filter of Process, but all the rules matching an issue are counted.
+func (p ExcludeRules) countMatches(issue *result.Issue) bool {
+	matched := false
+
+	for i, rule := range p.rules {
+		if !rule.match(issue, p.files, p.log) {
+			continue
+		}
+
+		matched = true
+
+		if i < len(p.keys) {
+			p.unused.match(p.keys[i])
+		}
+	}
+
+	return !matched
+}
+
 func (ExcludeRules) Finish() {}
 
 func createRules(rules []config.ExcludeRule, prefix string) []excludeRule {
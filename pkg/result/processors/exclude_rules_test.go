@@ -1,6 +1,7 @@
 package processors
 
 import (
+	"go/token"
 	"path"
 	"path/filepath"
 	"testing"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
@@ -49,7 +51,7 @@ func TestExcludeRules_multiple(t *testing.T) {
 		},
 	}}
 
-	p := NewExcludeRules(nil, files, opts)
+	p := NewExcludeRules(nil, files, opts, nil, nil, nil)
 
 	cases := []issueTestCase{
 		{Path: "e.go", Text: "exclude", Linter: "linter"},
@@ -105,7 +107,7 @@ func TestExcludeRules_pathPrefix(t *testing.T) {
 		},
 	}
 
-	p := NewExcludeRules(nil, files, opts)
+	p := NewExcludeRules(nil, files, opts, nil, nil, nil)
 
 	cases := []issueTestCase{
 		{Path: "e.go"},
@@ -148,7 +150,7 @@ func TestExcludeRules_text(t *testing.T) {
 		},
 	}
 
-	p := NewExcludeRules(nil, nil, opts)
+	p := NewExcludeRules(nil, nil, opts, nil, nil, nil)
 
 	texts := []string{"excLude", "1", "", "exclud", "notexclude"}
 	var issues []result.Issue
@@ -171,7 +173,7 @@ func TestExcludeRules_text(t *testing.T) {
 }
 
 func TestExcludeRules_empty(t *testing.T) {
-	processAssertSame(t, NewExcludeRules(nil, nil, &config.Issues{}), newIssueFromTextTestCase("test"))
+	processAssertSame(t, NewExcludeRules(nil, nil, &config.Issues{}, nil, nil, nil), newIssueFromTextTestCase("test"))
 }
 
 func TestExcludeRules_caseSensitive_multiple(t *testing.T) {
@@ -208,7 +210,7 @@ func TestExcludeRules_caseSensitive_multiple(t *testing.T) {
 		},
 	}
 
-	p := NewExcludeRules(nil, files, opts)
+	p := NewExcludeRules(nil, files, opts, nil, nil, nil)
 
 	cases := []issueTestCase{
 		{Path: "e.go", Text: "exclude", Linter: "linter"},
@@ -264,7 +266,7 @@ func TestExcludeRules_caseSensitive_text(t *testing.T) {
 		},
 	}
 
-	p := NewExcludeRules(nil, nil, opts)
+	p := NewExcludeRules(nil, nil, opts, nil, nil, nil)
 
 	texts := []string{"exclude", "excLude", "1", "", "exclud", "notexclude"}
 
@@ -288,5 +290,45 @@ func TestExcludeRules_caseSensitive_text(t *testing.T) {
 }
 
 func TestExcludeRules_caseSensitive_empty(t *testing.T) {
-	processAssertSame(t, NewExcludeRules(nil, nil, &config.Issues{ExcludeCaseSensitive: true}), newIssueFromTextTestCase("test"))
+	processAssertSame(t, NewExcludeRules(nil, nil, &config.Issues{ExcludeCaseSensitive: true}, nil, nil, nil), newIssueFromTextTestCase("test"))
+}
+
+func TestExcludeRules_unused(t *testing.T) {
+	lineCache := fsutils.NewLineCache(fsutils.NewFileCache())
+	files := fsutils.NewFiles(lineCache, "")
+
+	cfg := &config.Issues{
+		ExcludeRules: []config.ExcludeRule{
+			{BaseRule: config.BaseRule{Text: "^root$", Linters: []string{"linter"}}},
+			{BaseRule: config.BaseRule{Text: "^nested$", Linters: []string{"linter"}}},
+			{BaseRule: config.BaseRule{Path: "unused", Linters: []string{"linter"}}},
+		},
+		UseDefaultExcludes:     true,
+		ReportUnusedExclusions: true,
+	}
+
+	// The rules of a nested configuration include the rules of its parent configuration.
+	nestedCfg := &config.Issues{
+		ExcludeRules: append(cfg.ExcludeRules,
+			config.ExcludeRule{BaseRule: config.BaseRule{Text: "^nested_unused$"}},
+		),
+	}
+
+	positions := []string{".golangci.yml:5", ".golangci.yml:8", ".golangci.yml:11"}
+
+	log := logutils.NewMockLog()
+	log.On("Warnf", "The %s %s (%s) doesn't match any issue", "exclude rule", ".golangci.yml:11", "path: unused; linters: linter").Once()
+	log.On("Warnf", "The %s %s (%s) doesn't match any issue", "exclude rule", "sub/.golangci.yml:2", "text: ^nested_unused$").Once()
+
+	unused := NewUnusedExclusions(log, cfg)
+
+	p := NewExcludeRules(nil, files, cfg, unused, nil, positions)
+	nested := NewExcludeRules(nil, files, nestedCfg, unused, nil, append(positions, "sub/.golangci.yml:2"))
+
+	processAssertEmpty(t, p, result.Issue{Text: "root", FromLinter: "linter", Pos: token.Position{Filename: "root.go"}})
+	processAssertEmpty(t, nested, result.Issue{Text: "nested", FromLinter: "linter", Pos: token.Position{Filename: "sub/nested.go"}})
+
+	unused.Finish()
+
+	log.AssertExpectations(t)
 }
@@ -6,11 +6,12 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
 
 func TestExclude(t *testing.T) {
-	p := NewExclude(&config.Issues{ExcludePatterns: []string{"^exclude$"}})
+	p := NewExclude(&config.Issues{ExcludePatterns: []string{"^exclude$"}}, nil, nil)
 
 	texts := []string{"excLude", "1", "", "exclud", "notexclude"}
 
@@ -31,11 +32,11 @@ func TestExclude(t *testing.T) {
 }
 
 func TestExclude_empty(t *testing.T) {
-	processAssertSame(t, NewExclude(&config.Issues{}), newIssueFromTextTestCase("test"))
+	processAssertSame(t, NewExclude(&config.Issues{}, nil, nil), newIssueFromTextTestCase("test"))
 }
 
 func TestExclude_caseSensitive(t *testing.T) {
-	p := NewExclude(&config.Issues{ExcludePatterns: []string{"^exclude$"}, ExcludeCaseSensitive: true})
+	p := NewExclude(&config.Issues{ExcludePatterns: []string{"^exclude$"}, ExcludeCaseSensitive: true}, nil, nil)
 
 	texts := []string{"excLude", "1", "", "exclud", "exclude"}
 
@@ -54,3 +55,21 @@ func TestExclude_caseSensitive(t *testing.T) {
 
 	assert.Equal(t, texts[:len(texts)-1], processedTexts)
 }
+
+func TestExclude_unused(t *testing.T) {
+	cfg := &config.Issues{ExcludePatterns: []string{"^used$", "^unused$", "used"}, ReportUnusedExclusions: true}
+
+	log := logutils.NewMockLog()
+	log.On("Warnf", "The %s %s (%s) doesn't match any issue", "exclude pattern", "issues.exclude[1]", "^unused$").Once()
+
+	unused := NewUnusedExclusions(log, cfg)
+
+	p := NewExclude(cfg, unused, []string{".golangci.yml:3"})
+
+	processAssertEmpty(t, p, newIssueFromTextTestCase("used"))
+
+	p.Finish()
+	unused.Finish()
+
+	log.AssertExpectations(t)
+}
@@ -0,0 +1,84 @@
+package processors
+
+import (
+	"time"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+var _ Processor = (*ExpiredExcludeRules)(nil)
+
+// ExpiredExcludeRules reports the expired exclude rules (`issues.fail-on-expired-suppressions`).
+// The rules are registered by the ExcludeRules processors.
+//
+// The rules are identified by their positions in the configuration files:
+// a rule shared by several configurations (`run.nested-configs`) is reported once.
+type ExpiredExcludeRules struct {
+	log logutils.Log
+
+	fail bool
+	now  time.Time
+
+	rules map[string]config.ExcludeRule
+	keys  []string // The keys of the expired rules, in the order of the configuration.
+}
+
+func NewExpiredExcludeRules(log logutils.Log, cfg *config.Issues) *ExpiredExcludeRules {
+	return &ExpiredExcludeRules{
+		log:   log,
+		fail:  cfg.FailOnExpiredSuppressions,
+		now:   time.Now(),
+		rules: map[string]config.ExcludeRule{},
+	}
+}
+
+func (*ExpiredExcludeRules) Name() string {
+	return "expired-exclude-rules"
+}
+
+func (*ExpiredExcludeRules) Process(issues []result.Issue) ([]result.Issue, error) {
+	return issues, nil
+}
+
+func (p *ExpiredExcludeRules) Finish() {
+	if p == nil {
+		return
+	}
+
+	for _, key := range p.keys {
+		rule := p.rules[key]
+
+		details := rule.SuppressionInfo.String()
+		if details != "" {
+			details = " (" + details + ")"
+		}
+
+		if p.fail {
+			p.log.Errorf("Exclude rule %s expired on %s%s", key, rule.Expires, details)
+		} else {
+			p.log.Warnf("Exclude rule %s expired on %s%s", key, rule.Expires, details)
+		}
+	}
+}
+
+// register registers the expired rules of a configuration.
+func (p *ExpiredExcludeRules) register(rules []config.ExcludeRule, positions []string) {
+	if p == nil {
+		return
+	}
+
+	for i, rule := range rules {
+		if !rule.IsExpired(p.now) {
+			continue
+		}
+
+		key := exclusionKey("issues.exclude-rules", positions, i)
+
+		if _, ok := p.rules[key]; !ok {
+			p.rules[key] = rule
+			p.keys = append(p.keys, key)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package processors
+
+import (
+	"testing"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestExpiredExcludeRules(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		fail   bool
+		method string
+	}{
+		{
+			desc:   "warning",
+			method: "Warnf",
+		},
+		{
+			desc:   "failure",
+			fail:   true,
+			method: "Errorf",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := &config.Issues{
+				ExcludeRules: []config.ExcludeRule{
+					{
+						BaseRule:        config.BaseRule{Text: "^expired$", Linters: []string{"linter"}},
+						SuppressionInfo: config.SuppressionInfo{Owner: "team-a", Expires: "2000-01-01", Reason: "legacy"},
+					},
+					{
+						BaseRule:        config.BaseRule{Text: "^valid$", Linters: []string{"linter"}},
+						SuppressionInfo: config.SuppressionInfo{Expires: "2999-01-01"},
+					},
+				},
+				FailOnExpiredSuppressions: test.fail,
+			}
+
+			// The rules of a nested configuration include the rules of its parent configuration.
+			nestedCfg := &config.Issues{
+				ExcludeRules: append(cfg.ExcludeRules,
+					config.ExcludeRule{
+						BaseRule:        config.BaseRule{Text: "^nested$"},
+						SuppressionInfo: config.SuppressionInfo{Expires: "2001-01-01"},
+					},
+				),
+			}
+
+			positions := []string{".golangci.yml:5", ".golangci.yml:10"}
+
+			log := logutils.NewMockLog()
+			log.On(test.method, "Exclude rule %s expired on %s%s", ".golangci.yml:5", "2000-01-01", " (owner: team-a; reason: legacy)").Once()
+			log.On(test.method, "Exclude rule %s expired on %s%s", "sub/.golangci.yml:2", "2001-01-01", "").Once()
+
+			expired := NewExpiredExcludeRules(log, cfg)
+
+			p := NewExcludeRules(nil, nil, cfg, nil, expired, positions)
+			_ = NewExcludeRules(nil, nil, nestedCfg, nil, expired, append(positions, "sub/.golangci.yml:2"))
+
+			// The expired rules still exclude the issues.
+			processAssertEmpty(t, p,
+				result.Issue{Text: "expired", FromLinter: "linter"},
+				result.Issue{Text: "valid", FromLinter: "linter"},
+			)
+
+			expired.Finish()
+
+			log.AssertExpectations(t)
+		})
+	}
+}
+
+func TestExpiredExcludeRules_noPositions(t *testing.T) {
+	cfg := &config.Issues{
+		ExcludeRules: []config.ExcludeRule{
+			{BaseRule: config.BaseRule{Text: "^valid$"}},
+			{BaseRule: config.BaseRule{Text: "^expired$"}, SuppressionInfo: config.SuppressionInfo{Expires: "2000-01-01"}},
+		},
+	}
+
+	log := logutils.NewMockLog()
+	log.On("Warnf", "Exclude rule %s expired on %s%s", "issues.exclude-rules[1]", "2000-01-01", "").Once()
+
+	expired := NewExpiredExcludeRules(log, cfg)
+
+	_ = NewExcludeRules(nil, nil, cfg, nil, expired, nil)
+
+	expired.Finish()
+
+	log.AssertExpectations(t)
+}
@@ -0,0 +1,239 @@
+package processors
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// Number of lines around the issue line used by the fingerprint.
+const fingerprintSnippetRadius = 1
+
+var _ Processor = (*Fingerprinter)(nil)
+
+// Fingerprinter computes the stable fingerprints of the issues (result.StableFingerprintVersion).
+//
+// The fingerprint is based on the directory of the file, the enclosing declaration (function, method, type, etc.),
+// the linter, the text, and the whitespace-normalized lines around the issue:
+// it doesn't change when lines are shifted, the file is renamed, or the code is reformatted.
+type Fingerprinter struct {
+	log       logutils.Log
+	fileCache *fsutils.FileCache
+	lineCache *fsutils.LineCache
+
+	// The number of issues by fingerprint: kept between the batches of issues (`output.stream`),
+	// the identical issues have the same fingerprints as in a run without streaming.
+	seen map[string]int
+}
+
+func NewFingerprinter(log logutils.Log, fileCache *fsutils.FileCache, lineCache *fsutils.LineCache) *Fingerprinter {
+	return &Fingerprinter{
+		log:       log,
+		fileCache: fileCache,
+		lineCache: lineCache,
+		seen:      map[string]int{},
+	}
+}
+
+func (*Fingerprinter) Name() string {
+	return "fingerprint"
+}
+
+func (p *Fingerprinter) Process(issues []result.Issue) ([]result.Issue, error) {
+	retIssues := slices.Clone(issues)
+
+	// Identical issues are disambiguated by their order in the file.
+	order := make([]int, len(retIssues))
+	for i := range order {
+		order[i] = i
+	}
+
+	slices.SortStableFunc(order, func(a, b int) int {
+		return comparePositions(retIssues[a].Pos, retIssues[b].Pos)
+	})
+
+	fset := token.NewFileSet()
+	files := map[string]*ast.File{}
+
+	for _, i := range order {
+		issue := &retIssues[i]
+
+		fingerprint := p.fingerprint(issue, p.scope(issue, fset, files))
+
+		if n := p.seen[fingerprint]; n > 0 {
+			p.seen[fingerprint]++
+			fingerprint = hashFingerprint(fingerprint, fmt.Sprint(n))
+		} else {
+			p.seen[fingerprint] = 1
+		}
+
+		issue.StableFingerprint = fingerprint
+	}
+
+	return retIssues, nil
+}
+
+func (*Fingerprinter) Finish() {}
+
+func (p *Fingerprinter) fingerprint(issue *result.Issue, scope string) string {
+	var snippet []string
+	for line := issue.Line() - fingerprintSnippetRadius; line <= issue.Line()+fingerprintSnippetRadius; line++ {
+		if line < 1 {
+			continue
+		}
+
+		src, err := p.lineCache.GetLine(issue.FilePath(), line)
+		if err != nil {
+			continue
+		}
+
+		if normalized := strings.Join(strings.Fields(src), " "); normalized != "" {
+			snippet = append(snippet, normalized)
+		}
+	}
+
+	return hashFingerprint(fmt.Sprint(result.StableFingerprintVersion), issue.FromLinter, issue.Text, scope,
+		strings.Join(snippet, "\n"))
+}
+
+// scope returns the identity of the declaration enclosing the issue,
+// or the file name when the issue is outside any declaration.
+func (p *Fingerprinter) scope(issue *result.Issue, fset *token.FileSet, files map[string]*ast.File) string {
+	dir := filepath.ToSlash(filepath.Dir(issue.FilePath()))
+
+	file, ok := files[issue.FilePath()]
+	if !ok {
+		file = p.parseFile(fset, issue.FilePath())
+		files[issue.FilePath()] = file
+	}
+
+	if file != nil {
+		if decl := enclosingDeclName(fset, file, issue.Line()); decl != "" {
+			return dir + ":" + decl
+		}
+	}
+
+	return dir + ":" + filepath.Base(issue.FilePath())
+}
+
+func (p *Fingerprinter) parseFile(fset *token.FileSet, filePath string) *ast.File {
+	if filepath.Ext(filePath) != ".go" {
+		return nil
+	}
+
+	src, err := p.fileCache.GetFileBytes(filePath)
+	if err != nil {
+		p.log.Infof("Can't read file %s: %s", filePath, err)
+		return nil
+	}
+
+	// The AST is partial on syntax errors but it's still usable.
+	file, _ := parser.ParseFile(fset, filePath, src, parser.ParseComments|parser.SkipObjectResolution)
+
+	return file
+}
+
+func enclosingDeclName(fset *token.FileSet, file *ast.File, line int) string {
+	contains := func(doc *ast.CommentGroup, node ast.Node) bool {
+		start := node.Pos()
+		if doc != nil {
+			start = doc.Pos()
+		}
+
+		return fset.Position(start).Line <= line && line <= fset.Position(node.End()).Line
+	}
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if !contains(d.Doc, d) {
+				continue
+			}
+
+			if d.Recv != nil && len(d.Recv.List) > 0 {
+				return "func " + receiverTypeName(d.Recv.List[0].Type) + "." + d.Name.Name
+			}
+
+			return "func " + d.Name.Name
+
+		case *ast.GenDecl:
+			if !contains(d.Doc, d) || len(d.Specs) == 0 {
+				continue
+			}
+
+			// The spec containing the line, or the first one (e.g. the issue is on the `var (` line).
+			spec := d.Specs[0]
+			for _, s := range d.Specs {
+				if fset.Position(s.Pos()).Line <= line && line <= fset.Position(s.End()).Line {
+					spec = s
+					break
+				}
+			}
+
+			return d.Tok.String() + " " + specName(spec)
+		}
+	}
+
+	return ""
+}
+
+func receiverTypeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return receiverTypeName(e.X)
+	case *ast.IndexExpr:
+		return receiverTypeName(e.X)
+	case *ast.IndexListExpr:
+		return receiverTypeName(e.X)
+	case *ast.ParenExpr:
+		return receiverTypeName(e.X)
+	case *ast.Ident:
+		return e.Name
+	default:
+		return ""
+	}
+}
+
+func specName(spec ast.Spec) string {
+	switch s := spec.(type) {
+	case *ast.TypeSpec:
+		return s.Name.Name
+	case *ast.ValueSpec:
+		if len(s.Names) > 0 {
+			return s.Names[0].Name
+		}
+	case *ast.ImportSpec:
+		return s.Path.Value
+	}
+
+	return ""
+}
+
+func hashFingerprint(parts ...string) string {
+	hash := sha256.New()
+	_, _ = hash.Write([]byte(strings.Join(parts, "\x00")))
+
+	// 128 bits are enough.
+	return fmt.Sprintf("%X", hash.Sum(nil)[:16])
+}
+
+func comparePositions(a, b token.Position) int {
+	if c := strings.Compare(a.Filename, b.Filename); c != 0 {
+		return c
+	}
+
+	if a.Line != b.Line {
+		return a.Line - b.Line
+	}
+
+	return a.Column - b.Column
+}
@@ -0,0 +1,120 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const fingerprintSrc = `package a
+
+// Foo is a type.
+type Foo struct{}
+
+func (f *Foo) Bar() {
+	_ = 1
+}
+
+func Baz() {
+	_ = 1
+}
+`
+
+func TestFingerprinter(t *testing.T) {
+	dir := t.TempDir()
+
+	fingerprints := func(fileName, src string, lines ...int) []string {
+		t.Helper()
+
+		filePath := filepath.Join(dir, fileName)
+
+		err := os.WriteFile(filePath, []byte(src), 0o600)
+		require.NoError(t, err)
+
+		fileCache := fsutils.NewFileCache()
+		p := NewFingerprinter(logutils.NewStderrLog(logutils.DebugKeyEmpty), fileCache, fsutils.NewLineCache(fileCache))
+
+		var issues []result.Issue
+		for _, line := range lines {
+			issues = append(issues, result.Issue{
+				FromLinter: "linter",
+				Text:       "text",
+				Pos:        token.Position{Filename: filePath, Line: line},
+			})
+		}
+
+		issues, err = p.Process(issues)
+		require.NoError(t, err)
+
+		var ret []string
+		for _, issue := range issues {
+			require.Len(t, issue.StableFingerprint, 32)
+			ret = append(ret, issue.StableFingerprint)
+		}
+
+		return ret
+	}
+
+	// doc comment, method, function.
+	expected := fingerprints("a.go", fingerprintSrc, 3, 7, 11)
+
+	// the same code in the method and in the function.
+	assert.NotEqual(t, expected[1], expected[2])
+
+	// lines shifted, code reformatted, file renamed.
+	reformatted := "package a\n\n\n// Foo is a type.\ntype Foo struct{}\n\nfunc (f *Foo) Bar() {\n\t_ =  1\n}\n\n\n\nfunc Baz() {\n  _ = 1\n}\n"
+
+	assert.Equal(t, expected, fingerprints("b.go", reformatted, 4, 8, 14))
+}
+
+func TestFingerprinter_identicalIssues(t *testing.T) {
+	fileCache := fsutils.NewFileCache()
+	p := NewFingerprinter(logutils.NewStderrLog(logutils.DebugKeyEmpty), fileCache, fsutils.NewLineCache(fileCache))
+
+	issue := result.Issue{
+		FromLinter: "linter",
+		Text:       "text",
+		Pos:        token.Position{Filename: "missing.go", Line: 1},
+	}
+
+	issues, err := p.Process([]result.Issue{issue, issue})
+	require.NoError(t, err)
+
+	require.Len(t, issues, 2)
+	assert.NotEqual(t, issues[0].StableFingerprint, issues[1].StableFingerprint)
+}
+
+func TestFingerprinter_identicalIssues_batches(t *testing.T) {
+	issue := result.Issue{
+		FromLinter: "linter",
+		Text:       "text",
+		Pos:        token.Position{Filename: "missing.go", Line: 1},
+	}
+
+	newFingerprinter := func() *Fingerprinter {
+		fileCache := fsutils.NewFileCache()
+		return NewFingerprinter(logutils.NewStderrLog(logutils.DebugKeyEmpty), fileCache, fsutils.NewLineCache(fileCache))
+	}
+
+	expected, err := newFingerprinter().Process([]result.Issue{issue, issue})
+	require.NoError(t, err)
+
+	// The issues are streamed (`output.stream`).
+	p := newFingerprinter()
+
+	first, err := p.Process([]result.Issue{issue})
+	require.NoError(t, err)
+
+	second, err := p.Process([]result.Issue{issue})
+	require.NoError(t, err)
+
+	assert.Equal(t, expected, append(first, second...))
+}
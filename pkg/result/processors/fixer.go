@@ -5,9 +5,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 
+	"github.com/hexops/gotextdiff"
+	"github.com/hexops/gotextdiff/myers"
+	"github.com/hexops/gotextdiff/span"
+	"golang.org/x/exp/maps"
+
 	"github.com/golangci/golangci-lint/internal/robustio"
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
@@ -55,6 +61,13 @@ func (p Fixer) Process(issues []result.Issue) ([]result.Issue, error) {
 		issuesToFixPerFile[issue.FilePath()] = append(issuesToFixPerFile[issue.FilePath()], *issue)
 	}
 
+	if p.cfg.Issues.FixOutput != "" {
+		p.printDiff(issuesToFixPerFile)
+
+		// nothing was fixed: show all the issues
+		return issues, nil
+	}
+
 	for file, issuesToFix := range issuesToFixPerFile {
 		var err error
 		p.sw.TrackStage("all", func() {
@@ -75,6 +88,64 @@ func (p Fixer) Process(issues []result.Issue) ([]result.Issue, error) {
 
 func (Fixer) Finish() {}
 
+// printDiff writes the unified diff of the fixes instead of modifying the files.
+func (p Fixer) printDiff(issuesToFixPerFile map[string][]result.Issue) {
+	files := maps.Keys(issuesToFixPerFile)
+	slices.Sort(files)
+
+	var patch strings.Builder
+	for _, file := range files {
+		p.sw.TrackStage("all", func() {
+			fileDiff, err := p.diffIssuesInFile(file, issuesToFixPerFile[file])
+			if err != nil {
+				p.log.Errorf("Failed to compute fixes of issues in file %s: %s", file, err)
+				return
+			}
+
+			patch.WriteString(fileDiff)
+		})
+	}
+
+	p.printStat()
+
+	if err := p.writeDiff(patch.String()); err != nil {
+		p.log.Errorf("Failed to write fixes diff: %s", err)
+	}
+}
+
+func (p Fixer) diffIssuesInFile(filePath string, issues []result.Issue) (string, error) {
+	origFileData, err := p.fileCache.GetFileBytes(filePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to get file bytes for %s: %w", filePath, err)
+	}
+
+	origContent := string(origFileData)
+	fixedContent := string(p.fixFileData(origFileData, issues))
+	if origContent == fixedContent {
+		return "", nil
+	}
+
+	diffPath := filepath.ToSlash(filePath)
+	edits := myers.ComputeEdits(span.URIFromPath(filePath), origContent, fixedContent)
+
+	return fmt.Sprint(gotextdiff.ToUnified("a/"+diffPath, "b/"+diffPath, origContent, edits)), nil
+}
+
+func (p Fixer) writeDiff(patch string) error {
+	_, path, _ := strings.Cut(p.cfg.Issues.FixOutput, ":")
+	if path == "" || path == "stdout" {
+		_, err := fmt.Fprint(logutils.StdOut, patch)
+		return err
+	}
+
+	err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, []byte(patch), 0o644)
+}
+
 func (p Fixer) fixIssuesInFile(filePath string, issues []result.Issue) error {
 	// TODO: don't read the whole file into memory: read line by line;
 	// can't just use bufio.scanner: it has a line length limit
@@ -83,7 +154,7 @@ func (p Fixer) fixIssuesInFile(filePath string, issues []result.Issue) error {
 		return fmt.Errorf("failed to get file bytes for %s: %w", filePath, err)
 	}
 
-	origFileLines := bytes.Split(origFileData, []byte("\n"))
+	fixedFileData := p.fixFileData(origFileData, issues)
 
 	tmpFileName := filepath.Join(filepath.Dir(filePath), fmt.Sprintf(".%s.golangci_fix", filepath.Base(filePath)))
 
@@ -92,38 +163,203 @@ func (p Fixer) fixIssuesInFile(filePath string, issues []result.Issue) error {
 		return fmt.Errorf("failed to make file %s: %w", tmpFileName, err)
 	}
 
+	if _, err = tmpOutFile.Write(fixedFileData); err != nil {
+		tmpOutFile.Close()
+		_ = robustio.RemoveAll(tmpOutFile.Name())
+		return fmt.Errorf("failed to write fixed file: %w", err)
+	}
+
+	tmpOutFile.Close()
+
+	if err = robustio.Rename(tmpOutFile.Name(), filePath); err != nil {
+		_ = robustio.RemoveAll(tmpOutFile.Name())
+		return fmt.Errorf("failed to rename %s -> %s: %w", tmpOutFile.Name(), filePath, err)
+	}
+
+	return nil
+}
+
+// fixFileData returns the content of the file after applying all the not conflicting fixes of the issues.
+func (p Fixer) fixFileData(origFileData []byte, issues []result.Issue) []byte {
+	origFileLines := bytes.Split(origFileData, []byte("\n"))
+
+	var lineIssues, editIssues []result.Issue
+	for i := range issues {
+		if len(issues[i].Replacement.TextEdits) != 0 {
+			editIssues = append(editIssues, issues[i])
+		} else {
+			lineIssues = append(lineIssues, issues[i])
+		}
+	}
+
 	// merge multiple issues per line into one issue
 	issuesPerLine := map[int][]result.Issue{}
-	for i := range issues {
-		issue := &issues[i]
+	for i := range lineIssues {
+		issue := &lineIssues[i]
 		issuesPerLine[issue.Line()] = append(issuesPerLine[issue.Line()], *issue)
 	}
 
-	issues = issues[:0] // reuse the same memory
-	for line, lineIssues := range issuesPerLine {
-		if mergedIssue := p.mergeLineIssues(line, lineIssues, origFileLines); mergedIssue != nil {
-			issues = append(issues, *mergedIssue)
+	lineIssues = lineIssues[:0] // reuse the same memory
+	for line, perLineIssues := range issuesPerLine {
+		if mergedIssue := p.mergeLineIssues(line, perLineIssues, origFileLines); mergedIssue != nil {
+			lineIssues = append(lineIssues, *mergedIssue)
 		}
 	}
 
-	issues = p.findNotIntersectingIssues(issues)
+	lineIssues = p.findNotIntersectingIssues(lineIssues)
 
-	if err = p.writeFixedFile(origFileLines, issues, tmpOutFile); err != nil {
-		tmpOutFile.Close()
-		_ = robustio.RemoveAll(tmpOutFile.Name())
-		return err
+	lineOffsets := make([]int, len(origFileLines)+1)
+	for i, line := range origFileLines {
+		lineOffsets[i+1] = lineOffsets[i] + len(line) + 1 // +1 for the "\n"
 	}
 
-	tmpOutFile.Close()
+	// line based fixes go first: they were already checked against each other.
+	fixes := make([]fileFix, 0, len(lineIssues)+len(editIssues))
+	for i := range lineIssues {
+		if from := lineIssues[i].GetLineRange().From; from < 1 || from > len(origFileLines) {
+			p.log.Warnf("Skip issue %#v: line %d is out of the file", &lineIssues[i], from)
+			continue
+		}
 
-	if err = robustio.Rename(tmpOutFile.Name(), filePath); err != nil {
-		_ = robustio.RemoveAll(tmpOutFile.Name())
-		return fmt.Errorf("failed to rename %s -> %s: %w", tmpOutFile.Name(), filePath, err)
+		fixes = append(fixes, p.lineIssueToFix(&lineIssues[i], origFileLines, lineOffsets, len(origFileData)))
+	}
+
+	sort.SliceStable(editIssues, func(i, j int) bool {
+		return editIssues[i].Replacement.TextEdits[0].Pos < editIssues[j].Replacement.TextEdits[0].Pos
+	})
+
+	for i := range editIssues {
+		issue := &editIssues[i]
+		if err := validateTextEdits(issue.Replacement.TextEdits, len(origFileData)); err != nil {
+			p.log.Warnf("Skip issue %#v: invalid text edits: %v", issue, err)
+			continue
+		}
+
+		fixes = append(fixes, fileFix{issue: issue, edits: issue.Replacement.TextEdits})
+	}
+
+	return applyTextEdits(origFileData, p.findNotConflictingEdits(fixes))
+}
+
+// fileFix is a set of edits of one issue: either all of them are applied or none.
+type fileFix struct {
+	issue *result.Issue
+	edits []result.TextEdit
+}
+
+// lineIssueToFix converts a line based replacement into byte-offset based edits.
+// lineOffsets[i] is the offset of the (i+1)th line.
+func (p Fixer) lineIssueToFix(issue *result.Issue, origFileLines [][]byte, lineOffsets []int, fileSize int) fileFix {
+	rng := issue.GetLineRange()
+	if rng.From > rng.To {
+		// Maybe better decision is to skip such issues, re-evaluate if regressed.
+		p.log.Warnf("[fixer]: issue line range is probably invalid, fix can be incorrect (from=%d, to=%d, linter=%s)",
+			rng.From, rng.To, issue.FromLinter,
+		)
+		rng.To = rng.From
+	}
+	rng.To = min(rng.To, len(origFileLines))
+
+	edit := result.TextEdit{Pos: lineOffsets[rng.From-1]}
+
+	if issue.Replacement.NeedOnlyDelete {
+		// delete lines with their line endings
+		edit.End = fileSize
+		if rng.To < len(origFileLines) {
+			edit.End = lineOffsets[rng.To]
+		}
+	} else {
+		edit.End = lineOffsets[rng.To-1] + len(origFileLines[rng.To-1])
+		edit.NewText = strings.Join(issue.Replacement.NewLines, "\n")
+	}
+
+	return fileFix{issue: issue, edits: []result.TextEdit{edit}}
+}
+
+// findNotConflictingEdits returns the edits of the fixes not overlapping with the edits of the previous fixes.
+// Identical edits (e.g. from the same diagnostic reported twice) are applied only once.
+func (p Fixer) findNotConflictingEdits(fixes []fileFix) []result.TextEdit {
+	var ret []result.TextEdit
+
+	for _, fix := range fixes {
+		var newEdits []result.TextEdit
+		conflicted := false
+
+		for _, edit := range fix.edits {
+			duplicated := false
+			for _, accepted := range append(ret, newEdits...) {
+				if edit == accepted {
+					duplicated = true
+					break
+				}
+
+				if editsOverlap(edit, accepted) {
+					conflicted = true
+					break
+				}
+			}
+
+			if conflicted {
+				break
+			}
+
+			if !duplicated {
+				newEdits = append(newEdits, edit)
+			}
+		}
+
+		if conflicted {
+			p.log.Infof("Skip issue %#v: conflicts with another fix", fix.issue)
+			continue
+		}
+
+		p.log.Infof("Fix issue %#v with %d edits", fix.issue, len(newEdits))
+		ret = append(ret, newEdits...)
+	}
+
+	return ret
+}
+
+func editsOverlap(a, b result.TextEdit) bool {
+	if a.Pos == b.Pos {
+		// two insertions at the same place or an insertion at the start of a replaced range:
+		// the order of the edits is ambiguous.
+		return true
+	}
+
+	return a.Pos < b.End && b.Pos < a.End
+}
+
+func validateTextEdits(edits []result.TextEdit, fileSize int) error {
+	for _, edit := range edits {
+		if edit.Pos < 0 || edit.End < edit.Pos || edit.End > fileSize {
+			return fmt.Errorf("edit [%d, %d) is out of the file bounds [0, %d)", edit.Pos, edit.End, fileSize)
+		}
 	}
 
 	return nil
 }
 
+// applyTextEdits applies not overlapping edits to the data.
+func applyTextEdits(data []byte, edits []result.TextEdit) []byte {
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].Pos < edits[j].Pos
+	})
+
+	var buf bytes.Buffer
+	buf.Grow(len(data))
+
+	lastPos := 0
+	for _, edit := range edits {
+		buf.Write(data[lastPos:edit.Pos])
+		buf.WriteString(edit.NewText)
+		lastPos = edit.End
+	}
+	buf.Write(data[lastPos:])
+
+	return buf.Bytes()
+}
+
 func (p Fixer) mergeLineIssues(lineNum int, lineIssues []result.Issue, origFileLines [][]byte) *result.Issue {
 	origLine := origFileLines[lineNum-1] // lineNum is 1-based
 
@@ -215,47 +451,6 @@ func (p Fixer) findNotIntersectingIssues(issues []result.Issue) []result.Issue {
 	return ret
 }
 
-func (p Fixer) writeFixedFile(origFileLines [][]byte, issues []result.Issue, tmpOutFile *os.File) error {
-	// issues aren't intersecting
-
-	nextIssueIndex := 0
-	for i := 0; i < len(origFileLines); i++ {
-		var outLine string
-		var nextIssue *result.Issue
-		if nextIssueIndex != len(issues) {
-			nextIssue = &issues[nextIssueIndex]
-		}
-
-		origFileLineNumber := i + 1
-		if nextIssue == nil || origFileLineNumber != nextIssue.GetLineRange().From {
-			outLine = string(origFileLines[i])
-		} else {
-			nextIssueIndex++
-			rng := nextIssue.GetLineRange()
-			if rng.From > rng.To {
-				// Maybe better decision is to skip such issues, re-evaluate if regressed.
-				p.log.Warnf("[fixer]: issue line range is probably invalid, fix can be incorrect (from=%d, to=%d, linter=%s)",
-					rng.From, rng.To, nextIssue.FromLinter,
-				)
-			}
-			i += rng.To - rng.From
-			if nextIssue.Replacement.NeedOnlyDelete {
-				continue
-			}
-			outLine = strings.Join(nextIssue.Replacement.NewLines, "\n")
-		}
-
-		if i < len(origFileLines)-1 {
-			outLine += "\n"
-		}
-		if _, err := tmpOutFile.WriteString(outLine); err != nil {
-			return fmt.Errorf("failed to write output line: %w", err)
-		}
-	}
-
-	return nil
-}
-
 func (p Fixer) printStat() {
 	p.sw.PrintStages()
 }
@@ -0,0 +1,160 @@
+package processors
+
+import (
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+func TestFixer_fixFileData(t *testing.T) {
+	const src = "package a\n\nfunc a() {\n\tfoo := 1\n\t_ = foo\n}\n"
+
+	testCases := []struct {
+		desc     string
+		issues   []result.Issue
+		expected string
+	}{
+		{
+			desc: "multi-line text edit",
+			issues: []result.Issue{
+				newFixIssue(3, result.TextEdit{Pos: 11, End: 42, NewText: "func a() {}"}),
+			},
+			expected: "package a\n\nfunc a() {}\n",
+		},
+		{
+			desc: "multiple edits per fix",
+			issues: []result.Issue{
+				newFixIssue(4,
+					result.TextEdit{Pos: 23, End: 26, NewText: "bar"},
+					result.TextEdit{Pos: 37, End: 40, NewText: "bar"},
+				),
+			},
+			expected: "package a\n\nfunc a() {\n\tbar := 1\n\t_ = bar\n}\n",
+		},
+		{
+			desc: "insertion",
+			issues: []result.Issue{
+				newFixIssue(1, result.TextEdit{Pos: 0, End: 0, NewText: "// Package a.\n"}),
+			},
+			expected: "// Package a.\n" + src,
+		},
+		{
+			desc: "conflicting fixes",
+			issues: []result.Issue{
+				newFixIssue(4, result.TextEdit{Pos: 23, End: 26, NewText: "bar"}),
+				newFixIssue(4, result.TextEdit{Pos: 24, End: 30, NewText: "baz"}),
+			},
+			expected: "package a\n\nfunc a() {\n\tbar := 1\n\t_ = foo\n}\n",
+		},
+		{
+			desc: "duplicated fixes",
+			issues: []result.Issue{
+				newFixIssue(4, result.TextEdit{Pos: 23, End: 26, NewText: "bar"}),
+				newFixIssue(4, result.TextEdit{Pos: 23, End: 26, NewText: "bar"}),
+			},
+			expected: "package a\n\nfunc a() {\n\tbar := 1\n\t_ = foo\n}\n",
+		},
+		{
+			desc: "out of bounds edit",
+			issues: []result.Issue{
+				newFixIssue(4, result.TextEdit{Pos: 23, End: 100, NewText: "bar"}),
+			},
+			expected: src,
+		},
+		{
+			desc: "text edits and line replacements",
+			issues: []result.Issue{
+				{
+					FromLinter:  "linter",
+					Pos:         newFixPos(5),
+					Replacement: &result.Replacement{NeedOnlyDelete: true},
+				},
+				newFixIssue(4, result.TextEdit{Pos: 23, End: 26, NewText: "bar"}),
+			},
+			expected: "package a\n\nfunc a() {\n\tbar := 1\n}\n",
+		},
+		{
+			desc: "text edit conflicting with line replacement",
+			issues: []result.Issue{
+				{
+					FromLinter:  "linter",
+					Pos:         newFixPos(4),
+					Replacement: &result.Replacement{NewLines: []string{"\tbaz := 1"}},
+				},
+				newFixIssue(4, result.TextEdit{Pos: 23, End: 26, NewText: "bar"}),
+			},
+			expected: "package a\n\nfunc a() {\n\tbaz := 1\n\t_ = foo\n}\n",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			p := NewFixer(&config.Config{}, logutils.NewStderrLog(logutils.DebugKeyEmpty), fsutils.NewFileCache())
+
+			assert.Equal(t, test.expected, string(p.fixFileData([]byte(src), test.issues)))
+		})
+	}
+}
+
+func newFixPos(line int) token.Position {
+	return token.Position{Filename: "a.go", Line: line}
+}
+
+func newFixIssue(line int, edits ...result.TextEdit) result.Issue {
+	return result.Issue{
+		FromLinter:  "linter",
+		Pos:         newFixPos(line),
+		Replacement: &result.Replacement{TextEdits: edits},
+	}
+}
+
+func TestFixer_Process_fixOutput(t *testing.T) {
+	dir := t.TempDir()
+
+	filePath := filepath.Join(dir, "a.go")
+	patchPath := filepath.Join(dir, "fixes.patch")
+
+	const src = "package a\n\nvar foo = 1\n"
+
+	err := os.WriteFile(filePath, []byte(src), 0o600)
+	require.NoError(t, err)
+
+	cfg := &config.Config{Issues: config.Issues{NeedFix: true, FixOutput: "diff:" + patchPath}}
+
+	p := NewFixer(cfg, logutils.NewStderrLog(logutils.DebugKeyEmpty), fsutils.NewFileCache())
+
+	issue := newFixIssue(3, result.TextEdit{Pos: 15, End: 18, NewText: "bar"})
+	issue.Pos.Filename = filePath
+
+	issues, err := p.Process([]result.Issue{issue})
+	require.NoError(t, err)
+
+	// nothing is fixed: the issue is still reported.
+	assert.Len(t, issues, 1)
+
+	data, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	assert.Equal(t, src, string(data))
+
+	patch, err := os.ReadFile(patchPath)
+	require.NoError(t, err)
+
+	diffPath := filepath.ToSlash(filePath)
+
+	expected := "--- a/" + diffPath + "\n+++ b/" + diffPath + "\n" +
+		"@@ -1,3 +1,3 @@\n package a\n \n-var foo = 1\n+var bar = 1\n"
+
+	assert.Equal(t, expected, string(patch))
+}
@@ -0,0 +1,77 @@
+package processors
+
+import (
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+var _ Processor = (*Nested)(nil)
+
+// Nested processes the issues with the processor of the nearest configuration (`run.nested-configs`):
+// the issues inside the directory of a nested configuration are processed by the processor of this configuration,
+// the other issues by the processor of the root configuration.
+type Nested struct {
+	cfg *config.Config
+
+	root   Processor
+	nested []Processor
+}
+
+// NewNested creates a processor using the processors of the configurations.
+// The nested processors are in the order of the nested configurations (config.Config.GetNestedConfigs).
+// Returns the root processor if there are no nested configurations.
+func NewNested(cfg *config.Config, root Processor, nested []Processor) Processor {
+	if len(nested) == 0 {
+		return root
+	}
+
+	return &Nested{cfg: cfg, root: root, nested: nested}
+}
+
+func (p *Nested) Name() string {
+	return p.root.Name()
+}
+
+func (p *Nested) Process(issues []result.Issue) ([]result.Issue, error) {
+	var rootIssues []result.Issue
+
+	nestedIssues := make([][]result.Issue, len(p.nested))
+
+	for i := range issues {
+		index := p.cfg.NearestNestedConfig(issues[i].FilePath())
+		if index == -1 {
+			rootIssues = append(rootIssues, issues[i])
+			continue
+		}
+
+		nestedIssues[index] = append(nestedIssues[index], issues[i])
+	}
+
+	outIssues, err := p.root.Process(rootIssues)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, nested := range p.nested {
+		if len(nestedIssues[i]) == 0 {
+			continue
+		}
+
+		processed, err := nested.Process(nestedIssues[i])
+		if err != nil {
+			return nil, err
+		}
+
+		outIssues = append(outIssues, processed...)
+	}
+
+	return outIssues, nil
+}
+
+func (p *Nested) Finish() {
+	p.root.Finish()
+
+	for _, nested := range p.nested {
+		nested.Finish()
+	}
+}
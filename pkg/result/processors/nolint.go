@@ -1,15 +1,18 @@
 package processors
 
 import (
+	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/token"
 	"regexp"
 	"sort"
 	"strings"
+	"time"
 
 	"golang.org/x/exp/maps"
 
+	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/golinters/nolintlint"
 	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/lint/lintersdb"
@@ -21,12 +24,74 @@ var _ Processor = (*Nolint)(nil)
 
 var nolintDebugf = logutils.Debug(logutils.DebugKeyNolint)
 
+var nolintPattern = regexp.MustCompile(`^nolint( |:|$)`)
+
+// NolintDirective is a nolint directive: `//nolint:linter1,linter2 // explanation`.
+type NolintDirective struct {
+	// Linters are the names of the linters, empty for all the linters.
+	Linters []string
+
+	// The metadata are read from the explanation:
+	// `owner=<owner>`, `expires=<YYYY-MM-DD>`, `issue=<ticket>`, the remaining text is the reason.
+	config.SuppressionInfo
+}
+
+// ParseNolintDirective parses the text of a comment, returns nil if the comment is not a nolint directive.
+func ParseNolintDirective(comment string) *NolintDirective {
+	text := strings.TrimLeft(comment, "/ ")
+	if !nolintPattern.MatchString(text) {
+		return nil
+	}
+
+	text, explanation, _ := strings.Cut(text, "//") // allow another comment after this comment
+
+	directive := &NolintDirective{SuppressionInfo: parseNolintExplanation(explanation)}
+
+	if strings.HasPrefix(text, "nolint:all") || !strings.HasPrefix(text, "nolint:") {
+		return directive
+	}
+
+	for _, item := range strings.Split(strings.TrimPrefix(text, "nolint:"), ",") {
+		directive.Linters = append(directive.Linters, strings.ToLower(strings.TrimSpace(item)))
+	}
+
+	return directive
+}
+
+func parseNolintExplanation(explanation string) config.SuppressionInfo {
+	var (
+		info   config.SuppressionInfo
+		reason []string
+	)
+
+	for _, field := range strings.Fields(explanation) {
+		key, value, _ := strings.Cut(field, "=")
+
+		switch key {
+		case "owner":
+			info.Owner = value
+		case "expires":
+			info.Expires = value
+		case "issue":
+			info.Issue = value
+		default:
+			reason = append(reason, field)
+		}
+	}
+
+	info.Reason = strings.Join(reason, " ")
+
+	return info
+}
+
 type ignoredRange struct {
 	linters                []string
 	matchedIssueFromLinter map[string]bool
 	result.Range
 	col           int
 	originalRange *ignoredRange // pre-expanded range (used to match nolintlint issues)
+
+	info config.SuppressionInfo
 }
 
 func (i *ignoredRange) doesMatch(issue *result.Issue) bool {
@@ -72,17 +137,25 @@ type Nolint struct {
 
 	unknownLintersSet map[string]bool
 
-	pattern *regexp.Regexp
+	// expired are the expiration dates of the expired directives suppressing issues, by position.
+	expired map[string]string
+	// invalid are the errors of the directives with invalid metadata (e.g. a malformed expiration date), by position.
+	invalid       map[string]string
+	failOnExpired bool
+	now           time.Time
 }
 
-func NewNolint(log logutils.Log, dbManager *lintersdb.Manager, enabledLinters map[string]*linter.Config) *Nolint {
+func NewNolint(log logutils.Log, dbManager *lintersdb.Manager, enabledLinters map[string]*linter.Config, cfg *config.Issues) *Nolint {
 	return &Nolint{
 		fileCache:         map[string]*fileData{},
 		dbManager:         dbManager,
 		enabledLinters:    enabledLinters,
 		log:               log,
 		unknownLintersSet: map[string]bool{},
-		pattern:           regexp.MustCompile(`^nolint( |:|$)`),
+		expired:           map[string]string{},
+		invalid:           map[string]string{},
+		failOnExpired:     cfg.FailOnExpiredSuppressions,
+		now:               time.Now(),
 	}
 }
 
@@ -97,6 +170,9 @@ func (p *Nolint) Process(issues []result.Issue) ([]result.Issue, error) {
 }
 
 func (p *Nolint) Finish() {
+	p.reportExpired()
+	p.reportInvalid()
+
 	if len(p.unknownLintersSet) == 0 {
 		return
 	}
@@ -132,8 +208,14 @@ func (p *Nolint) shouldPassIssue(issue *result.Issue) (bool, error) {
 
 		ir.matchedIssueFromLinter[issue.FromLinter] = true
 
+		from := ir.From
 		if ir.originalRange != nil {
 			ir.originalRange.matchedIssueFromLinter[issue.FromLinter] = true
+			from = ir.originalRange.From
+		}
+
+		if ir.info.IsExpired(p.now) {
+			p.expired[fmt.Sprintf("%s:%d", issue.FilePath(), from)] = ir.info.Expires
 		}
 
 		return false, nil
@@ -206,11 +288,17 @@ func (p *Nolint) extractFileCommentsInlineRanges(fset *token.FileSet, comments .
 }
 
 func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *token.FileSet) *ignoredRange {
-	text = strings.TrimLeft(text, "/ ")
-	if !p.pattern.MatchString(text) {
+	directive := ParseNolintDirective(text)
+	if directive == nil {
 		return nil
 	}
 
+	// An invalid expiration date never expires: the directive is reported.
+	if err := directive.Validate(); err != nil {
+		pos := fset.Position(g.Pos())
+		p.invalid[fmt.Sprintf("%s:%d", pos.Filename, pos.Line)] = err.Error()
+	}
+
 	buildRange := func(linters []string) *ignoredRange {
 		pos := fset.Position(g.Pos())
 		return &ignoredRange{
@@ -221,19 +309,17 @@ func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *to
 			col:                    pos.Column,
 			linters:                linters,
 			matchedIssueFromLinter: make(map[string]bool),
+			info:                   directive.SuppressionInfo,
 		}
 	}
 
-	if strings.HasPrefix(text, "nolint:all") || !strings.HasPrefix(text, "nolint:") {
+	if len(directive.Linters) == 0 {
 		return buildRange(nil) // ignore all linters
 	}
 
 	// ignore specific linters
 	var linters []string
-	text = strings.Split(text, "//")[0] // allow another comment after this comment
-	linterItems := strings.Split(strings.TrimPrefix(text, "nolint:"), ",")
-	for _, item := range linterItems {
-		linterName := strings.ToLower(strings.TrimSpace(item))
+	for _, linterName := range directive.Linters {
 		if linterName == "all" {
 			p.unknownLintersSet = map[string]bool{}
 			return buildRange(nil)
@@ -256,6 +342,35 @@ func (p *Nolint) extractInlineRangeFromComment(text string, g ast.Node, fset *to
 	return buildRange(linters)
 }
 
+// reportExpired logs the expired directives suppressing issues (`issues.fail-on-expired-suppressions`).
+func (p *Nolint) reportExpired() {
+	positions := maps.Keys(p.expired)
+	sort.Strings(positions)
+
+	for _, position := range positions {
+		if p.failOnExpired {
+			p.log.Errorf("The nolint directive at %s expired on %s", position, p.expired[position])
+		} else {
+			p.log.Warnf("The nolint directive at %s expired on %s", position, p.expired[position])
+		}
+	}
+}
+
+// reportInvalid logs the directives with invalid metadata,
+// as errors with `issues.fail-on-expired-suppressions`: a malformed expiration date never expires.
+func (p *Nolint) reportInvalid() {
+	positions := maps.Keys(p.invalid)
+	sort.Strings(positions)
+
+	for _, position := range positions {
+		if p.failOnExpired {
+			p.log.Errorf("The nolint directive at %s is invalid: %s", position, p.invalid[position])
+		} else {
+			p.log.Warnf("The nolint directive at %s is invalid: %s", position, p.invalid[position])
+		}
+	}
+}
+
 type rangeExpander struct {
 	fset           *token.FileSet
 	inlineRanges   []ignoredRange
@@ -36,7 +36,7 @@ func newNolint2FileIssue(line int) result.Issue {
 func newTestNolintProcessor(log logutils.Log) *Nolint {
 	dbManager, _ := lintersdb.NewManager(log, config.NewDefault(), lintersdb.NewLinterBuilder())
 
-	return NewNolint(log, dbManager, nil)
+	return NewNolint(log, dbManager, nil, &config.Issues{})
 }
 
 func getMockLog() *logutils.MockLog {
@@ -291,7 +291,7 @@ func TestNolintUnused(t *testing.T) {
 		enabledLintersMap, err := dbManager.GetEnabledLintersMap()
 		require.NoError(t, err)
 
-		return NewNolint(log, dbManager, enabledLintersMap)
+		return NewNolint(log, dbManager, enabledLintersMap, &config.Issues{})
 	}
 
 	// the issue below is the nolintlint issue that would be generated for the test file
@@ -355,9 +355,105 @@ func TestNolintUnused(t *testing.T) {
 		enabledLintersMap, err := dbManager.GetEnabledLintersMap()
 		require.NoError(t, err)
 
-		p := NewNolint(log, dbManager, enabledLintersMap)
+		p := NewNolint(log, dbManager, enabledLintersMap, &config.Issues{})
 		defer p.Finish()
 
 		processAssertEmpty(t, p, nolintlintIssueVarcheck)
 	})
 }
+
+func TestNolintExpired(t *testing.T) {
+	fileName := filepath.Join("testdata", "nolint_expired.go")
+
+	testCases := []struct {
+		desc   string
+		cfg    *config.Issues
+		method string
+	}{
+		{
+			desc:   "warning",
+			cfg:    &config.Issues{},
+			method: "Warnf",
+		},
+		{
+			desc:   "failure",
+			cfg:    &config.Issues{FailOnExpiredSuppressions: true},
+			method: "Errorf",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			log := getMockLog()
+			log.On(test.method, "The nolint directive at %s expired on %s", fileName+":4", "2000-01-01").Once()
+			log.On(test.method, "The nolint directive at %s is invalid: %s", fileName+":6",
+				`invalid expires date "2024-13-01": the expected format is YYYY-MM-DD`).Once()
+
+			dbManager, err := lintersdb.NewManager(log, config.NewDefault(), lintersdb.NewLinterBuilder())
+			require.NoError(t, err)
+
+			p := NewNolint(log, dbManager, nil, test.cfg)
+
+			for _, line := range []int{4, 5} {
+				issue := newNolintFileIssue(line, "errcheck")
+				issue.Pos.Filename = fileName
+
+				processAssertEmpty(t, p, issue)
+			}
+
+			p.Finish()
+
+			log.AssertExpectations(t)
+		})
+	}
+}
+
+func TestParseNolintDirective(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		comment  string
+		expected *NolintDirective
+	}{
+		{
+			desc:     "not a directive",
+			comment:  "// some comment",
+			expected: nil,
+		},
+		{
+			desc:     "all linters",
+			comment:  "//nolint",
+			expected: &NolintDirective{},
+		},
+		{
+			desc:     "all linters with explanation",
+			comment:  "//nolint:all // generated code",
+			expected: &NolintDirective{SuppressionInfo: config.SuppressionInfo{Reason: "generated code"}},
+		},
+		{
+			desc:     "linters",
+			comment:  "//nolint:errcheck, GoSec",
+			expected: &NolintDirective{Linters: []string{"errcheck", "gosec"}},
+		},
+		{
+			desc:    "metadata",
+			comment: "//nolint:gosec // weak hash owner=team-a expires=2024-12-31 issue=PROJ-123 is fine here",
+			expected: &NolintDirective{
+				Linters: []string{"gosec"},
+				SuppressionInfo: config.SuppressionInfo{
+					Reason:  "weak hash is fine here",
+					Owner:   "team-a",
+					Expires: "2024-12-31",
+					Issue:   "PROJ-123",
+				},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, ParseNolintDirective(test.comment))
+		})
+	}
+}
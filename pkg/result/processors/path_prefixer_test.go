@@ -19,6 +19,16 @@ func TestPathPrefixer_Process(t *testing.T) {
 		return
 	}
 
+	prefixed := func(prefix string, ps ...string) (issues []result.Issue) {
+		for _, p := range ps {
+			issues = append(issues, result.Issue{
+				Pos:      token.Position{Filename: filepath.Join(prefix, filepath.FromSlash(p))},
+				RealPath: filepath.FromSlash(p),
+			})
+		}
+		return
+	}
+
 	for _, tt := range []struct {
 		name, prefix string
 		issues, want []result.Issue
@@ -32,13 +42,13 @@ func TestPathPrefixer_Process(t *testing.T) {
 			name:   "prefix",
 			prefix: "ok",
 			issues: paths("some/path", "cool"),
-			want:   paths("ok/some/path", "ok/cool"),
+			want:   prefixed("ok", "some/path", "cool"),
 		},
 		{
 			name:   "prefix slashed",
 			prefix: "ok/",
 			issues: paths("some/path", "cool"),
-			want:   paths("ok/some/path", "ok/cool"),
+			want:   prefixed("ok", "some/path", "cool"),
 		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
@@ -27,14 +28,24 @@ type Severity struct {
 
 	defaultSeverity string
 	rules           []severityRule
+
+	// linterSeverities are the default severities of the linters (`linter.Config.Severity`).
+	linterSeverities map[string]string
 }
 
-func NewSeverity(log logutils.Log, files *fsutils.Files, cfg *config.Severity) *Severity {
+func NewSeverity(log logutils.Log, files *fsutils.Files, cfg *config.Severity, enabledLinters map[string]*linter.Config) *Severity {
 	p := &Severity{
-		name:            "severity-rules",
-		files:           files,
-		log:             log,
-		defaultSeverity: cfg.Default,
+		name:             "severity-rules",
+		files:            files,
+		log:              log,
+		defaultSeverity:  cfg.Default,
+		linterSeverities: map[string]string{},
+	}
+
+	for name, lc := range enabledLinters {
+		if lc.Severity != "" {
+			p.linterSeverities[name] = lc.Severity
+		}
 	}
 
 	prefix := caseInsensitivePrefix
@@ -51,7 +62,7 @@ func NewSeverity(log logutils.Log, files *fsutils.Files, cfg *config.Severity) *
 func (p *Severity) Name() string { return p.name }
 
 func (p *Severity) Process(issues []result.Issue) ([]result.Issue, error) {
-	if len(p.rules) == 0 && p.defaultSeverity == "" {
+	if len(p.rules) == 0 && p.defaultSeverity == "" && len(p.linterSeverities) == 0 {
 		return issues, nil
 	}
 
@@ -64,7 +75,7 @@ func (p *Severity) transform(issue *result.Issue) *result.Issue {
 	for _, rule := range p.rules {
 		if rule.match(issue, p.files, p.log) {
 			if rule.severity == severityFromLinter || (rule.severity == "" && p.defaultSeverity == severityFromLinter) {
-				return issue
+				return p.fromLinter(issue)
 			}
 
 			issue.Severity = rule.severity
@@ -76,8 +87,19 @@ func (p *Severity) transform(issue *result.Issue) *result.Issue {
 		}
 	}
 
-	if p.defaultSeverity != severityFromLinter {
-		issue.Severity = p.defaultSeverity
+	if p.defaultSeverity == "" || p.defaultSeverity == severityFromLinter {
+		return p.fromLinter(issue)
+	}
+
+	issue.Severity = p.defaultSeverity
+
+	return issue
+}
+
+// fromLinter keeps the severity provided by the linter, or uses the default severity of the linter.
+func (p *Severity) fromLinter(issue *result.Issue) *result.Issue {
+	if issue.Severity == "" {
+		issue.Severity = p.linterSeverities[issue.FromLinter]
 	}
 
 	return issue
@@ -9,6 +9,7 @@ import (
 
 	"github.com/golangci/golangci-lint/pkg/config"
 	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/lint/linter"
 	"github.com/golangci/golangci-lint/pkg/logutils"
 	"github.com/golangci/golangci-lint/pkg/result"
 )
@@ -79,7 +80,7 @@ func TestSeverity_multiple(t *testing.T) {
 		},
 	}
 
-	p := NewSeverity(log, files, opts)
+	p := NewSeverity(log, files, opts, nil)
 
 	cases := []issueTestCase{
 		{Path: "ssl.go", Text: "ssl", Linter: "gosec"},
@@ -147,7 +148,7 @@ func TestSeverity_pathPrefix(t *testing.T) {
 		},
 	}
 
-	p := NewSeverity(log, files, opts)
+	p := NewSeverity(log, files, opts, nil)
 
 	cases := []issueTestCase{
 		{Path: "e.go", Text: "some", Linter: "linter"},
@@ -192,7 +193,7 @@ func TestSeverity_text(t *testing.T) {
 		},
 	}
 
-	p := NewSeverity(nil, nil, opts)
+	p := NewSeverity(nil, nil, opts, nil)
 
 	texts := []string{"seveRity", "1", "", "serverit", "notseverity"}
 	var issues []result.Issue
@@ -224,7 +225,7 @@ func TestSeverity_onlyDefault(t *testing.T) {
 		Rules:   []config.SeverityRule{},
 	}
 
-	p := NewSeverity(log, files, &opts)
+	p := NewSeverity(log, files, &opts, nil)
 
 	cases := []issueTestCase{
 		{Path: "ssl.go", Text: "ssl", Linter: "gosec"},
@@ -258,7 +259,7 @@ func TestSeverity_onlyDefault(t *testing.T) {
 }
 
 func TestSeverity_empty(t *testing.T) {
-	p := NewSeverity(nil, nil, &config.Severity{})
+	p := NewSeverity(nil, nil, &config.Severity{}, nil)
 
 	processAssertSame(t, p, newIssueFromTextTestCase("test"))
 }
@@ -281,7 +282,7 @@ func TestSeverity_caseSensitive(t *testing.T) {
 		CaseSensitive: true,
 	}
 
-	p := NewSeverity(nil, files, opts)
+	p := NewSeverity(nil, files, opts, nil)
 
 	cases := []issueTestCase{
 		{Path: "e.go", Text: "ssL", Linter: "gosec"},
@@ -319,6 +320,7 @@ func TestSeverity_transform(t *testing.T) {
 	testCases := []struct {
 		desc     string
 		opts     *config.Severity
+		linters  map[string]*linter.Config
 		issue    *result.Issue
 		expected *result.Issue
 	}{
@@ -480,13 +482,92 @@ func TestSeverity_transform(t *testing.T) {
 				Severity:   "huge",
 			},
 		},
+		{
+			desc: "apply default severity of the linter",
+			opts: &config.Severity{},
+			linters: map[string]*linter.Config{
+				"linter1": {Severity: "info"},
+			},
+			issue: &result.Issue{
+				Text:       "This is a report",
+				FromLinter: "linter1",
+			},
+			expected: &result.Issue{
+				Text:       "This is a report",
+				FromLinter: "linter1",
+				Severity:   "info",
+			},
+		},
+		{
+			desc: "apply default severity of the linter as rule",
+			opts: &config.Severity{
+				Default: "error",
+				Rules: []config.SeverityRule{
+					{
+						Severity: severityFromLinter,
+						BaseRule: config.BaseRule{
+							Linters: []string{"linter1"},
+						},
+					},
+				},
+			},
+			linters: map[string]*linter.Config{
+				"linter1": {Severity: "info"},
+			},
+			issue: &result.Issue{
+				Text:       "This is a report",
+				FromLinter: "linter1",
+			},
+			expected: &result.Issue{
+				Text:       "This is a report",
+				FromLinter: "linter1",
+				Severity:   "info",
+			},
+		},
+		{
+			desc: "severity from linter override default severity of the linter",
+			opts: &config.Severity{
+				Default: severityFromLinter,
+			},
+			linters: map[string]*linter.Config{
+				"linter1": {Severity: "info"},
+			},
+			issue: &result.Issue{
+				Text:       "This is a report",
+				FromLinter: "linter1",
+				Severity:   "warning",
+			},
+			expected: &result.Issue{
+				Text:       "This is a report",
+				FromLinter: "linter1",
+				Severity:   "warning",
+			},
+		},
+		{
+			desc: "severity from default override default severity of the linter",
+			opts: &config.Severity{
+				Default: "error",
+			},
+			linters: map[string]*linter.Config{
+				"linter1": {Severity: "info"},
+			},
+			issue: &result.Issue{
+				Text:       "This is a report",
+				FromLinter: "linter1",
+			},
+			expected: &result.Issue{
+				Text:       "This is a report",
+				FromLinter: "linter1",
+				Severity:   "error",
+			},
+		},
 	}
 
 	for _, test := range testCases {
 		t.Run(test.desc, func(t *testing.T) {
 			t.Parallel()
 
-			p := NewSeverity(nil, files, test.opts)
+			p := NewSeverity(nil, files, test.opts, test.linters)
 
 			newIssue := p.transform(test.issue)
 
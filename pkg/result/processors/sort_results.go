@@ -3,7 +3,6 @@ package processors
 import (
 	"errors"
 	"fmt"
-	"slices"
 	"sort"
 	"strings"
 
@@ -49,7 +48,8 @@ func (SortResults) Name() string { return "sort_results" }
 
 // Process is performing sorting of the result issues.
 func (p SortResults) Process(issues []result.Issue) ([]result.Issue, error) {
-	if !p.cfg.SortResults {
+	// The streamed issues are printed in the order of the linters.
+	if !p.cfg.SortResults || p.cfg.Stream {
 		return issues, nil
 	}
 
@@ -210,25 +210,24 @@ func findComparatorTip(cmp *comparator) *comparator {
 }
 
 func severityCompare(a, b string) compareResult {
-	// The position inside the slice define the importance (lower to higher).
-	classic := []string{"low", "medium", "high", "warning", "error"}
+	levelA, levelB := config.SeverityLevel(a), config.SeverityLevel(b)
 
-	if slices.Contains(classic, a) && slices.Contains(classic, b) {
+	if levelA >= 0 && levelB >= 0 {
 		switch {
-		case slices.Index(classic, a) > slices.Index(classic, b):
+		case levelA > levelB:
 			return greater
-		case slices.Index(classic, a) < slices.Index(classic, b):
+		case levelA < levelB:
 			return less
 		default:
 			return equal
 		}
 	}
 
-	if slices.Contains(classic, a) {
+	if levelA >= 0 {
 		return greater
 	}
 
-	if slices.Contains(classic, b) {
+	if levelB >= 0 {
 		return less
 	}
 
@@ -231,6 +231,20 @@ func TestSorting(t *testing.T) {
 	assert.Equal(t, []result.Issue{issues[3], issues[2], issues[1], issues[0]}, results)
 }
 
+func TestSorting_stream(t *testing.T) {
+	tests := make([]result.Issue, len(issues))
+	copy(tests, issues)
+
+	cfg := config.Config{}
+	cfg.Output.SortResults = true
+	cfg.Output.Stream = true
+	sr := NewSortResults(&cfg)
+
+	results, err := sr.Process(tests)
+	require.NoError(t, err)
+	assert.Equal(t, tests, results)
+}
+
 func Test_mergeComparators(t *testing.T) {
 	testCases := []struct {
 		desc     string
@@ -0,0 +1,7 @@
+package testdata
+
+func nolintExpired() {
+	var _ = 1 //nolint:errcheck // legacy code owner=team-a expires=2000-01-01 issue=PROJ-1
+	var _ = 2 //nolint:errcheck // expires=2999-01-01
+	var _ = 3 //nolint:errcheck // expires=2024-13-01
+}
@@ -0,0 +1,128 @@
+package processors
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+var _ Processor = (*UnusedExclusions)(nil)
+
+// UnusedExclusions reports the exclusions (`issues.exclude`, `issues.exclude-rules`) without matched issues
+// (`issues.report-unused-exclusions`).
+// The issues are counted by the Exclude and ExcludeRules processors: must be after them.
+//
+// The exclusions are identified by their positions in the configuration files:
+// an exclusion shared by several configurations (`run.nested-configs`) is unused only if it matches no issues in all of them.
+type UnusedExclusions struct {
+	log logutils.Log
+
+	enabled bool
+
+	exclusions map[string]*exclusion
+	keys       []string // The keys of the exclusions, in the order of the configuration.
+}
+
+type exclusion struct {
+	kind        string
+	description string
+	matches     int
+}
+
+func NewUnusedExclusions(log logutils.Log, cfg *config.Issues) *UnusedExclusions {
+	return &UnusedExclusions{
+		log:        log,
+		enabled:    cfg.ReportUnusedExclusions,
+		exclusions: map[string]*exclusion{},
+	}
+}
+
+func (*UnusedExclusions) Name() string {
+	return "unused-exclusions"
+}
+
+func (*UnusedExclusions) Process(issues []result.Issue) ([]result.Issue, error) {
+	return issues, nil
+}
+
+func (p *UnusedExclusions) Finish() {
+	if !p.isEnabled() {
+		return
+	}
+
+	for _, key := range p.keys {
+		e := p.exclusions[key]
+		if e.matches != 0 {
+			continue
+		}
+
+		p.log.Warnf("The %s %s (%s) doesn't match any issue", e.kind, key, e.description)
+	}
+}
+
+func (p *UnusedExclusions) isEnabled() bool {
+	return p != nil && p.enabled
+}
+
+// register registers an exclusion and returns its key (see exclusionKey).
+func (p *UnusedExclusions) register(kind, option string, positions []string, index int, description string) string {
+	if !p.isEnabled() {
+		return ""
+	}
+
+	key := exclusionKey(option, positions, index)
+
+	if _, ok := p.exclusions[key]; !ok {
+		p.exclusions[key] = &exclusion{kind: kind, description: description}
+		p.keys = append(p.keys, key)
+	}
+
+	return key
+}
+
+// exclusionKey returns the position of an exclusion, or the option and the index of the exclusion if the position is unknown.
+func exclusionKey(option string, positions []string, index int) string {
+	if index < len(positions) && positions[index] != "" {
+		return positions[index]
+	}
+
+	return fmt.Sprintf("%s[%d]", option, index)
+}
+
+func (p *UnusedExclusions) match(key string) {
+	if !p.isEnabled() {
+		return
+	}
+
+	p.exclusions[key].matches++
+}
+
+// describeRule returns the conditions of a rule.
+func describeRule(rule *config.BaseRule) string {
+	var parts []string
+
+	if rule.Path != "" {
+		parts = append(parts, "path: "+rule.Path)
+	}
+
+	if rule.PathExcept != "" {
+		parts = append(parts, "path-except: "+rule.PathExcept)
+	}
+
+	if len(rule.Linters) != 0 {
+		parts = append(parts, "linters: "+strings.Join(rule.Linters, ", "))
+	}
+
+	if rule.Text != "" {
+		parts = append(parts, "text: "+rule.Text)
+	}
+
+	if rule.Source != "" {
+		parts = append(parts, "source: "+rule.Source)
+	}
+
+	return strings.Join(parts, "; ")
+}
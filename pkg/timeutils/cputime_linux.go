@@ -0,0 +1,18 @@
+//go:build linux
+
+package timeutils
+
+import (
+	"syscall"
+	"time"
+)
+
+// threadCPUTime returns the CPU time (user and system) of the current thread.
+func threadCPUTime() (time.Duration, bool) {
+	var usage syscall.Rusage
+	if err := syscall.Getrusage(syscall.RUSAGE_THREAD, &usage); err != nil {
+		return 0, false
+	}
+
+	return time.Duration(usage.Utime.Nano() + usage.Stime.Nano()), true
+}
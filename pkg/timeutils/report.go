@@ -0,0 +1,295 @@
+package timeutils
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"runtime"
+	"slices"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Report records the durations of the analysis of each package (`golangci-lint run --timing-report`).
+// The methods of a nil report only call the tracked functions.
+type Report struct {
+	startedAt time.Time
+
+	load    time.Duration
+	actions map[actionKey]*ActionTiming
+	loads   map[string]time.Duration
+	cache   map[cacheKey]*CacheTiming
+	mu      sync.Mutex
+}
+
+type cacheKey struct {
+	pkg  string
+	kind string
+}
+
+type actionKey struct {
+	analyzer string
+	pkg      string
+}
+
+// ActionTiming is the time spent to run an analyzer on a package.
+type ActionTiming struct {
+	Analyzer string
+	Linters  []string // The linters using the analyzer.
+	Package  string
+	Wall     time.Duration
+	// CPU is the CPU time of the thread running the analyzer: the goroutines started by the analyzer are ignored.
+	// It's only measured on Linux.
+	CPU time.Duration
+}
+
+// CacheTiming is the number of loads from the cache of a kind of data.
+type CacheTiming struct {
+	Kind   string
+	Hits   int
+	Misses int
+}
+
+// LinterTiming is the time spent by a linter, including the analyzers it depends on.
+type LinterTiming struct {
+	Name string
+	Wall time.Duration
+	CPU  time.Duration
+}
+
+// PackageTiming is the time spent to load and analyze a package.
+type PackageTiming struct {
+	Package string
+	Load    time.Duration
+	Wall    time.Duration
+	CPU     time.Duration
+	Cache   []CacheTiming
+}
+
+// ReportData is the content of the timing report.
+// The durations are in nanoseconds.
+type ReportData struct {
+	Duration time.Duration
+	// Load is the time spent to list and load the packages (`go/packages`).
+	Load     time.Duration
+	Linters  []LinterTiming
+	Packages []PackageTiming
+	Actions  []ActionTiming
+}
+
+func NewReport() *Report {
+	return &Report{
+		startedAt: time.Now(),
+		actions:   map[actionKey]*ActionTiming{},
+		loads:     map[string]time.Duration{},
+		cache:     map[cacheKey]*CacheTiming{},
+	}
+}
+
+// TrackLoad tracks the listing and the loading of the packages.
+func (r *Report) TrackLoad(f func()) {
+	if r == nil {
+		f()
+		return
+	}
+
+	startedAt := time.Now()
+	f()
+
+	r.mu.Lock()
+	r.load += time.Since(startedAt)
+	r.mu.Unlock()
+}
+
+// TrackPackageLoad tracks the parsing and the type-checking of a package.
+func (r *Report) TrackPackageLoad(pkg string, f func()) {
+	if r == nil {
+		f()
+		return
+	}
+
+	startedAt := time.Now()
+	f()
+
+	r.mu.Lock()
+	r.loads[pkg] += time.Since(startedAt)
+	r.mu.Unlock()
+}
+
+// TrackAction tracks the run of an analyzer on a package.
+func (r *Report) TrackAction(analyzer, pkg string, linters []string, f func()) {
+	if r == nil {
+		f()
+		return
+	}
+
+	// The CPU time is measured by thread.
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	startedAtCPU, cpuOK := threadCPUTime()
+	startedAt := time.Now()
+
+	f()
+
+	wall := time.Since(startedAt)
+
+	var cpu time.Duration
+	if endedAtCPU, ok := threadCPUTime(); ok && cpuOK {
+		cpu = endedAtCPU - startedAtCPU
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := actionKey{analyzer: analyzer, pkg: pkg}
+
+	act, ok := r.actions[k]
+	if !ok {
+		act = &ActionTiming{Analyzer: analyzer, Linters: linters, Package: pkg}
+		r.actions[k] = act
+	}
+
+	act.Wall += wall
+	act.CPU += cpu
+}
+
+// CountCacheLoad counts a load of data of a package from the cache.
+func (r *Report) CountCacheLoad(pkg, kind string, hit bool) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	k := cacheKey{pkg: pkg, kind: kind}
+
+	c, ok := r.cache[k]
+	if !ok {
+		c = &CacheTiming{Kind: kind}
+		r.cache[k] = c
+	}
+
+	if hit {
+		c.Hits++
+	} else {
+		c.Misses++
+	}
+}
+
+// Data returns the content of the report.
+// The linters, packages, and actions are sorted by decreasing wall time.
+func (r *Report) Data() *ReportData {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data := &ReportData{
+		Duration: time.Since(r.startedAt),
+		Load:     r.load,
+	}
+
+	linters := map[string]*LinterTiming{}
+	packages := map[string]*PackageTiming{}
+
+	getPackage := func(name string) *PackageTiming {
+		p, ok := packages[name]
+		if !ok {
+			p = &PackageTiming{Package: name}
+			packages[name] = p
+		}
+		return p
+	}
+
+	for _, act := range r.actions {
+		data.Actions = append(data.Actions, *act)
+
+		for _, name := range act.Linters {
+			l, ok := linters[name]
+			if !ok {
+				l = &LinterTiming{Name: name}
+				linters[name] = l
+			}
+
+			l.Wall += act.Wall
+			l.CPU += act.CPU
+		}
+
+		p := getPackage(act.Package)
+		p.Wall += act.Wall
+		p.CPU += act.CPU
+	}
+
+	for name, d := range r.loads {
+		getPackage(name).Load += d
+	}
+
+	for k, c := range r.cache {
+		p := getPackage(k.pkg)
+		p.Cache = append(p.Cache, *c)
+	}
+
+	for _, l := range linters {
+		data.Linters = append(data.Linters, *l)
+	}
+
+	for _, p := range packages {
+		slices.SortFunc(p.Cache, func(a, b CacheTiming) int {
+			return cmp.Compare(a.Kind, b.Kind)
+		})
+
+		data.Packages = append(data.Packages, *p)
+	}
+
+	slices.SortFunc(data.Linters, func(a, b LinterTiming) int {
+		return cmp.Or(cmp.Compare(b.Wall, a.Wall), cmp.Compare(a.Name, b.Name))
+	})
+
+	slices.SortFunc(data.Packages, func(a, b PackageTiming) int {
+		return cmp.Or(cmp.Compare(b.Wall+b.Load, a.Wall+a.Load), cmp.Compare(a.Package, b.Package))
+	})
+
+	slices.SortFunc(data.Actions, func(a, b ActionTiming) int {
+		return cmp.Or(cmp.Compare(b.Wall, a.Wall), cmp.Compare(a.Analyzer, b.Analyzer), cmp.Compare(a.Package, b.Package))
+	})
+
+	return data
+}
+
+// PrintTop prints the n most expensive linters, packages, and analyzer runs.
+func (d *ReportData) PrintTop(w io.Writer, n int) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Total: %s (packages loading: %s)\n", round(d.Duration), round(d.Load))
+
+	fmt.Fprintf(tw, "\nTop %d linters:\n", n)
+	fmt.Fprintln(tw, "  LINTER\tWALL\tCPU\t")
+	for _, l := range d.Linters[:min(n, len(d.Linters))] {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t\n", l.Name, round(l.Wall), round(l.CPU))
+	}
+
+	fmt.Fprintf(tw, "\nTop %d packages:\n", n)
+	fmt.Fprintln(tw, "  PACKAGE\tLOAD\tWALL\tCPU\tCACHE HITS\tCACHE MISSES\t")
+	for _, p := range d.Packages[:min(n, len(d.Packages))] {
+		var hits, misses int
+		for _, c := range p.Cache {
+			hits += c.Hits
+			misses += c.Misses
+		}
+
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t%d\t%d\t\n", p.Package, round(p.Load), round(p.Wall), round(p.CPU), hits, misses)
+	}
+
+	fmt.Fprintf(tw, "\nTop %d analyzer runs:\n", n)
+	fmt.Fprintln(tw, "  ANALYZER\tPACKAGE\tWALL\tCPU\t")
+	for _, act := range d.Actions[:min(n, len(d.Actions))] {
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s\t\n", act.Analyzer, act.Package, round(act.Wall), round(act.CPU))
+	}
+
+	return tw.Flush()
+}
+
+func round(d time.Duration) time.Duration {
+	return d.Round(time.Millisecond)
+}
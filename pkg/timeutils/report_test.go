@@ -0,0 +1,60 @@
+package timeutils
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReport_Data(t *testing.T) {
+	r := NewReport()
+
+	r.TrackAction("buildssa", "a", []string{"unparam", "gosec"}, func() { time.Sleep(20 * time.Millisecond) })
+	r.TrackAction("unparam", "a", []string{"unparam"}, func() { time.Sleep(10 * time.Millisecond) })
+	r.TrackAction("gosec", "b", []string{"gosec"}, func() { time.Sleep(time.Millisecond) })
+	r.TrackPackageLoad("b", func() { time.Sleep(50 * time.Millisecond) })
+	r.CountCacheLoad("a", "issues", false)
+	r.CountCacheLoad("b", "issues", true)
+	r.CountCacheLoad("b", "facts", false)
+
+	data := r.Data()
+
+	require.Len(t, data.Actions, 3)
+	assert.Equal(t, "buildssa", data.Actions[0].Analyzer)
+	assert.Equal(t, "unparam", data.Actions[1].Analyzer)
+	assert.Equal(t, "gosec", data.Actions[2].Analyzer)
+
+	require.Len(t, data.Linters, 2)
+	assert.Equal(t, "unparam", data.Linters[0].Name)
+	assert.Equal(t, data.Actions[0].Wall+data.Actions[1].Wall, data.Linters[0].Wall)
+	assert.Equal(t, "gosec", data.Linters[1].Name)
+
+	// The loading time is included in the sort.
+	require.Len(t, data.Packages, 2)
+	assert.Equal(t, "b", data.Packages[0].Package)
+	assert.Equal(t, []CacheTiming{{Kind: "facts", Misses: 1}, {Kind: "issues", Hits: 1}}, data.Packages[0].Cache)
+	assert.Equal(t, "a", data.Packages[1].Package)
+	assert.Equal(t, []CacheTiming{{Kind: "issues", Misses: 1}}, data.Packages[1].Cache)
+
+	buf := &bytes.Buffer{}
+
+	err := data.PrintTop(buf, 1)
+	require.NoError(t, err)
+
+	assert.Contains(t, buf.String(), "Top 1 linters:\n  LINTER   WALL")
+	assert.Contains(t, buf.String(), "\n  unparam  ")
+	assert.NotContains(t, buf.String(), "\n  gosec  ")
+}
+
+func TestReport_nil(t *testing.T) {
+	var r *Report
+
+	var called bool
+	r.TrackAction("unparam", "a", nil, func() { called = true })
+	r.CountCacheLoad("a", "issues", true)
+
+	assert.True(t, called)
+}
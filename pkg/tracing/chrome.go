@@ -0,0 +1,60 @@
+package tracing
+
+import (
+	"encoding/json"
+	"io"
+	"slices"
+)
+
+// https://docs.google.com/document/d/1CvAClvFfyA5R-PhYUmn5OOQtYMH4h6I0nSsKchNAySU
+type chromeTrace struct {
+	TraceEvents     []chromeEvent `json:"traceEvents"`
+	DisplayTimeUnit string        `json:"displayTimeUnit"`
+}
+
+type chromeEvent struct {
+	Name      string            `json:"name"`
+	Phase     string            `json:"ph"`
+	Timestamp int64             `json:"ts"` // Microseconds.
+	Duration  int64             `json:"dur,omitempty"`
+	ProcessID int               `json:"pid"`
+	ThreadID  int               `json:"tid"`
+	Args      map[string]string `json:"args,omitempty"`
+}
+
+// WriteChrome writes the spans as Chrome trace events (chrome://tracing, https://ui.perfetto.dev).
+func WriteChrome(w io.Writer, spans []*Span) error {
+	trace := chromeTrace{
+		TraceEvents: []chromeEvent{{
+			Name:  "process_name",
+			Phase: "M",
+			Args:  map[string]string{"name": "golangci-lint"},
+		}},
+		DisplayTimeUnit: "ms",
+	}
+
+	if len(spans) != 0 {
+		origin := slices.MinFunc(spans, func(a, b *Span) int { return a.Start.Compare(b.Start) }).Start
+
+		for _, s := range spans {
+			var args map[string]string
+			if len(s.Attrs) != 0 {
+				args = make(map[string]string, len(s.Attrs))
+				for _, attr := range s.Attrs {
+					args[attr.Key] = attr.Value
+				}
+			}
+
+			trace.TraceEvents = append(trace.TraceEvents, chromeEvent{
+				Name:      s.Name,
+				Phase:     "X", // Complete event.
+				Timestamp: s.Start.Sub(origin).Microseconds(),
+				Duration:  max(s.End.Sub(s.Start).Microseconds(), 1),
+				ThreadID:  s.Lane,
+				Args:      args,
+			})
+		}
+	}
+
+	return json.NewEncoder(w).Encode(trace)
+}
@@ -0,0 +1,184 @@
+// Package tracing records the spans of a run (configuration loading, packages loading, analyzers, processors, printing)
+// to export them as Chrome trace events or to an OpenTelemetry collector.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+type tracerKey struct{}
+
+type spanKey struct{}
+
+// Attribute is a key-value pair describing a span.
+type Attribute struct {
+	Key   string
+	Value string
+}
+
+// Attr creates an attribute.
+func Attr(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Span is a timed operation.
+type Span struct {
+	tracer *Tracer
+
+	ID       uint64
+	ParentID uint64 // 0 for the root spans.
+	Name     string
+	Start    time.Time
+	End      time.Time
+	Attrs    []Attribute
+
+	// Lane is the index of the line where the span is displayed:
+	// a span is displayed on the line of its parent, unless the line is used by another span.
+	Lane int
+}
+
+// Finish ends the span.
+// It's a noop on a nil span.
+func (s *Span) Finish() {
+	if s == nil {
+		return
+	}
+
+	s.tracer.finish(s)
+}
+
+// Tracer records the spans.
+type Tracer struct {
+	traceID [16]byte
+
+	lastID uint64
+	spans  []*Span
+	lanes  [][]*Span // The stack of the open spans of each lane.
+	mu     sync.Mutex
+}
+
+func NewTracer() *Tracer {
+	t := &Tracer{}
+
+	_, _ = rand.Read(t.traceID[:])
+
+	return t
+}
+
+// TraceID returns the hexadecimal ID of the trace.
+func (t *Tracer) TraceID() string {
+	return hex.EncodeToString(t.traceID[:])
+}
+
+// Spans returns the finished spans.
+func (t *Tracer) Spans() []*Span {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	spans := make([]*Span, len(t.spans))
+	copy(spans, t.spans)
+
+	return spans
+}
+
+func (t *Tracer) start(parent *Span, name string, attrs []Attribute) *Span {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.lastID++
+
+	s := &Span{
+		tracer: t,
+		ID:     t.lastID,
+		Name:   name,
+		Start:  time.Now(),
+		Attrs:  attrs,
+		Lane:   -1,
+	}
+
+	if parent != nil {
+		s.ParentID = parent.ID
+
+		// The span is displayed under its parent if the parent is the innermost open span of its lane.
+		if stack := t.lanes[parent.Lane]; len(stack) > 0 && stack[len(stack)-1] == parent {
+			s.Lane = parent.Lane
+		}
+	}
+
+	if s.Lane == -1 {
+		for i, stack := range t.lanes {
+			if len(stack) == 0 {
+				s.Lane = i
+				break
+			}
+		}
+	}
+
+	if s.Lane == -1 {
+		s.Lane = len(t.lanes)
+		t.lanes = append(t.lanes, nil)
+	}
+
+	t.lanes[s.Lane] = append(t.lanes[s.Lane], s)
+
+	return s
+}
+
+func (t *Tracer) finish(s *Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !s.End.IsZero() {
+		return
+	}
+
+	s.End = time.Now()
+
+	stack := t.lanes[s.Lane]
+	for i := len(stack) - 1; i >= 0; i-- {
+		if stack[i] == s {
+			t.lanes[s.Lane] = append(stack[:i], stack[i+1:]...)
+			break
+		}
+	}
+
+	t.spans = append(t.spans, s)
+}
+
+// WithTracer returns a context recording the spans with the tracer.
+// The context is returned unchanged if the tracer is nil.
+func WithTracer(ctx context.Context, t *Tracer) context.Context {
+	if t == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, tracerKey{}, t)
+}
+
+// Start starts a span, child of the span of the context.
+// The returned context contains the new span.
+// If the context has no tracer, the context is returned unchanged with a nil span.
+func Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, *Span) {
+	t, ok := ctx.Value(tracerKey{}).(*Tracer)
+	if !ok {
+		return ctx, nil
+	}
+
+	parent, _ := ctx.Value(spanKey{}).(*Span)
+
+	s := t.start(parent, name, attrs)
+
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+// Track runs the function inside a span.
+func Track(ctx context.Context, name string, f func(ctx context.Context), attrs ...Attribute) {
+	ctx, s := Start(ctx, name, attrs...)
+	defer s.Finish()
+
+	f(ctx)
+}
@@ -0,0 +1,124 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStart(t *testing.T) {
+	tracer := NewTracer()
+
+	ctx := WithTracer(context.Background(), tracer)
+
+	rootCtx, root := Start(ctx, "root")
+
+	_, a := Start(rootCtx, "a", Attr("package", "foo"))
+	_, b := Start(rootCtx, "b") // concurrent with a.
+	a.Finish()
+
+	_, c := Start(rootCtx, "c")
+	c.Finish()
+	b.Finish()
+	root.Finish()
+
+	spans := tracer.Spans()
+	require.Len(t, spans, 4)
+
+	assert.Equal(t, "a", spans[0].Name)
+	assert.Equal(t, root.ID, spans[0].ParentID)
+	assert.Equal(t, []Attribute{{Key: "package", Value: "foo"}}, spans[0].Attrs)
+
+	assert.Equal(t, 0, root.Lane)
+	assert.Equal(t, 0, a.Lane)
+	assert.Equal(t, 1, b.Lane)
+	assert.Equal(t, 0, c.Lane)
+	assert.Zero(t, root.ParentID)
+}
+
+func TestStart_noTracer(t *testing.T) {
+	ctx := WithTracer(context.Background(), nil)
+
+	spanCtx, span := Start(ctx, "root")
+	assert.Nil(t, span)
+	assert.Equal(t, ctx, spanCtx)
+
+	span.Finish()
+}
+
+func TestWriteChrome(t *testing.T) {
+	tracer := NewTracer()
+
+	Track(WithTracer(context.Background(), tracer), "root", func(ctx context.Context) {
+		Track(ctx, "child", func(_ context.Context) {}, Attr("analyzer", "govet"))
+	})
+
+	buf := &bytes.Buffer{}
+
+	err := WriteChrome(buf, tracer.Spans())
+	require.NoError(t, err)
+
+	var trace chromeTrace
+	err = json.Unmarshal(buf.Bytes(), &trace)
+	require.NoError(t, err)
+
+	require.Len(t, trace.TraceEvents, 3)
+	assert.Equal(t, "M", trace.TraceEvents[0].Phase)
+
+	assert.Equal(t, "child", trace.TraceEvents[1].Name)
+	assert.Equal(t, "X", trace.TraceEvents[1].Phase)
+	assert.Equal(t, map[string]string{"analyzer": "govet"}, trace.TraceEvents[1].Args)
+
+	assert.Equal(t, "root", trace.TraceEvents[2].Name)
+	assert.Equal(t, int64(0), trace.TraceEvents[2].Timestamp)
+}
+
+func TestExportOTLP(t *testing.T) {
+	var received otlpRequest
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+	}))
+	t.Cleanup(srv.Close)
+
+	tracer := NewTracer()
+
+	Track(WithTracer(context.Background(), tracer), "root", func(ctx context.Context) {
+		Track(ctx, "child", func(_ context.Context) {})
+	})
+
+	err := ExportOTLP(context.Background(), srv.URL, "1.2.3", tracer)
+	require.NoError(t, err)
+
+	require.Len(t, received.ResourceSpans, 1)
+	require.Len(t, received.ResourceSpans[0].ScopeSpans, 1)
+
+	scope := received.ResourceSpans[0].ScopeSpans[0]
+	assert.Equal(t, otlpScope{Name: "golangci-lint", Version: "1.2.3"}, scope.Scope)
+
+	require.Len(t, scope.Spans, 2)
+	assert.Equal(t, "child", scope.Spans[0].Name)
+	assert.Equal(t, tracer.TraceID(), scope.Spans[0].TraceID)
+	assert.Equal(t, scope.Spans[1].SpanID, scope.Spans[0].ParentSpanID)
+	assert.Empty(t, scope.Spans[1].ParentSpanID)
+}
+
+func TestExportOTLP_error(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	t.Cleanup(srv.Close)
+
+	err := ExportOTLP(context.Background(), srv.URL+"/v1/traces", "", NewTracer())
+	require.Error(t, err)
+}
@@ -0,0 +1,131 @@
+package triage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+// defaultConfigPath is the configuration file created when there is no configuration file.
+const defaultConfigPath = ".golangci.yml"
+
+// excludeRule is an exclude rule (`issues.exclude-rules`) matching only one issue.
+type excludeRule struct {
+	Path    string   `yaml:"path"`
+	Linters []string `yaml:"linters"`
+	Text    string   `yaml:"text"`
+}
+
+// newExcludeRule creates the rule excluding only the issue.
+// The path of the rule keeps the prefix of `output.path-prefix`: the exclude rules are matched against the prefixed paths.
+func newExcludeRule(issue *result.Issue) excludeRule {
+	return excludeRule{
+		Path:    "^" + regexp.QuoteMeta(filepath.ToSlash(issue.FilePath())) + "$",
+		Linters: []string{issue.FromLinter},
+		Text:    regexp.QuoteMeta(issue.Text),
+	}
+}
+
+// addExcludeRule appends the rule to the exclude rules of the configuration file, and returns the path of the file.
+// The configuration file is created if the path is empty.
+func addExcludeRule(configPath string, rule excludeRule) (string, error) {
+	if configPath == "" {
+		configPath = defaultConfigPath
+	}
+
+	switch strings.ToLower(filepath.Ext(configPath)) {
+	case ".yml", ".yaml":
+	default:
+		return "", fmt.Errorf("the exclude rules can only be added to a YAML configuration file: %s", configPath)
+	}
+
+	var doc yaml.Node
+
+	content, err := os.ReadFile(configPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return "", fmt.Errorf("can't parse the configuration file %s: %w", configPath, err)
+	}
+
+	if len(doc.Content) == 0 {
+		doc = yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return "", fmt.Errorf("the configuration file %s is not a mapping", configPath)
+	}
+
+	issues, err := mappingValue(root, "issues", yaml.MappingNode)
+	if err != nil {
+		return "", fmt.Errorf("can't add the exclude rule to %s: %w", configPath, err)
+	}
+
+	rules, err := mappingValue(issues, "exclude-rules", yaml.SequenceNode)
+	if err != nil {
+		return "", fmt.Errorf("can't add the exclude rule to %s: %w", configPath, err)
+	}
+
+	ruleNode := &yaml.Node{}
+	if err := ruleNode.Encode(rule); err != nil {
+		return "", err
+	}
+
+	// The rules are written as a block (e.g. `exclude-rules: []`).
+	rules.Style &^= yaml.FlowStyle
+	rules.Content = append(rules.Content, ruleNode)
+
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(&doc); err != nil {
+		return "", err
+	}
+
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+
+	return configPath, os.WriteFile(configPath, buf.Bytes(), 0o644)
+}
+
+// mappingValue returns the value of the key, the value is created if the key doesn't exist.
+func mappingValue(node *yaml.Node, key string, kind yaml.Kind) (*yaml.Node, error) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value != key {
+			continue
+		}
+
+		value := node.Content[i+1]
+
+		// An empty value (e.g. `issues:`).
+		if value.Kind == yaml.ScalarNode && value.Tag == "!!null" {
+			*value = yaml.Node{Kind: kind}
+		}
+
+		if value.Kind != kind {
+			return nil, fmt.Errorf("unexpected type of %q", key)
+		}
+
+		return value, nil
+	}
+
+	value := &yaml.Node{Kind: kind}
+
+	node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+
+	return value, nil
+}
@@ -0,0 +1,101 @@
+package triage
+
+import (
+	"bytes"
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// nolintDirective matches the linters of a nolint directive (e.g. `//nolint:errcheck,gosec`).
+var nolintDirective = regexp.MustCompile(`^//\s*nolint:[\w-]+(,[\w-]+)*`)
+
+// insertNolint adds the linter to the nolint directive at the end of the line.
+// The linter is added to the existing directive, or a new directive is inserted before the comment of the line.
+func insertNolint(path string, line int, linterName, reason string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	lines := bytes.Split(content, []byte("\n"))
+	if line < 1 || line > len(lines) {
+		return fmt.Errorf("line %d is out of the file %s", line, path)
+	}
+
+	lines[line-1] = addNolint(lines[line-1], linterName, reason)
+
+	return os.WriteFile(path, bytes.Join(lines, []byte("\n")), info.Mode().Perm())
+}
+
+func addNolint(line []byte, linterName, reason string) []byte {
+	code, eol := line, []byte(nil)
+	if i := bytes.IndexByte(line, '\r'); i >= 0 {
+		code, eol = line[:i], line[i:]
+	}
+
+	commentStart := findComment(code)
+
+	var buf bytes.Buffer
+
+	if commentStart >= 0 {
+		comment := code[commentStart:]
+
+		if loc := nolintDirective.FindIndex(comment); loc != nil {
+			buf.Write(code[:commentStart+loc[1]])
+			buf.WriteString("," + linterName)
+			buf.Write(comment[loc[1]:])
+			buf.Write(eol)
+
+			return buf.Bytes()
+		}
+
+		buf.Write(code[:commentStart])
+	} else {
+		buf.Write(bytes.TrimRight(code, " \t"))
+		buf.WriteByte(' ')
+	}
+
+	buf.WriteString("//nolint:" + linterName)
+
+	if reason != "" {
+		buf.WriteString(" // " + reason)
+	}
+
+	if commentStart >= 0 {
+		buf.WriteByte(' ')
+		buf.Write(code[commentStart:])
+	}
+
+	buf.Write(eol)
+
+	return buf.Bytes()
+}
+
+// findComment returns the offset of the line comment, -1 if the line has no comment.
+func findComment(code []byte) int {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(code))
+
+	var s scanner.Scanner
+	s.Init(file, code, nil, scanner.ScanComments)
+
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			return -1
+		}
+
+		if tok == token.COMMENT && strings.HasPrefix(lit, "//") {
+			return file.Offset(pos)
+		}
+	}
+}
@@ -0,0 +1,342 @@
+package triage
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+	"golang.org/x/exp/maps"
+
+	"github.com/golangci/golangci-lint/pkg/config"
+	"github.com/golangci/golangci-lint/pkg/fsutils"
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+	"github.com/golangci/golangci-lint/pkg/result/processors"
+)
+
+// contextLines is the number of lines of source code displayed before and after an issue.
+const contextLines = 2
+
+type action string
+
+const (
+	actionFix     action = "f"
+	actionNolint  action = "n"
+	actionExclude action = "e"
+	actionSkip    action = "s"
+	actionQuit    action = "q"
+)
+
+var errQuit = errors.New("quit")
+
+// Stats counts the outcomes of the reviewed issues.
+type Stats struct {
+	Fixed    int
+	Ignored  int
+	Excluded int
+	Skipped  int
+}
+
+// Session is a guided review of the issues:
+// each issue can be fixed, ignored with a nolint directive, excluded with an exclude rule, or skipped.
+//
+// The issues of a file are reviewed from the bottom of the file to the top:
+// the modifications of a file never move the issues not reviewed yet.
+type Session struct {
+	log logutils.Log
+
+	in  *bufio.Reader
+	out io.Writer
+
+	// configPath is the configuration file receiving the exclude rules.
+	configPath string
+
+	lineCache *fsutils.LineCache
+
+	stats Stats
+}
+
+func NewSession(log logutils.Log, configPath string, r io.Reader, w io.Writer) *Session {
+	return &Session{
+		log:        log,
+		in:         bufio.NewReader(r),
+		out:        w,
+		configPath: configPath,
+		lineCache:  fsutils.NewLineCache(fsutils.NewFileCache()),
+	}
+}
+
+// Run reviews the issues until all the issues are reviewed or the user quits.
+// It returns the issues still present: the skipped issues, the not reviewed issues, and the issues of failed actions.
+func (s *Session) Run(issues []result.Issue) ([]result.Issue, error) {
+	issues = sortIssues(issues)
+
+	s.printSummary(issues)
+
+	var remaining []result.Issue
+
+	for i := range issues {
+		issue := &issues[i]
+
+		s.printIssue(i+1, len(issues), issue)
+
+		done, err := s.review(issue)
+		if errors.Is(err, errQuit) {
+			remaining = append(remaining, issues[i:]...)
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !done {
+			remaining = append(remaining, *issue)
+		}
+	}
+
+	s.printStats()
+
+	return remaining, nil
+}
+
+// Stats returns the outcomes of the reviewed issues.
+func (s *Session) Stats() Stats {
+	return s.stats
+}
+
+// review asks the action to apply on the issue until the action succeeds.
+// It returns false if the issue is still present.
+func (s *Session) review(issue *result.Issue) (bool, error) {
+	for {
+		act, err := s.askAction(issue)
+		if err != nil {
+			return false, err
+		}
+
+		switch act {
+		case actionFix:
+			err = s.fix(issue)
+			if err == nil {
+				s.stats.Fixed++
+			}
+
+		case actionNolint:
+			err = s.nolint(issue)
+			if err == nil {
+				s.stats.Ignored++
+			}
+
+		case actionExclude:
+			err = s.exclude(issue)
+			if err == nil {
+				s.stats.Excluded++
+			}
+
+		case actionSkip:
+			s.stats.Skipped++
+			return false, nil
+
+		case actionQuit:
+			return false, errQuit
+		}
+
+		if err != nil {
+			if errors.Is(err, errQuit) {
+				return false, err
+			}
+
+			fmt.Fprintf(s.out, "%s\n", color.RedString("Error: %v", err))
+
+			continue
+		}
+
+		return true, nil
+	}
+}
+
+func (s *Session) askAction(issue *result.Issue) (action, error) {
+	choices := []string{"[n]olint", "[e]xclude", "[s]kip", "[q]uit"}
+	if issue.Replacement != nil {
+		choices = append([]string{"[f]ix"}, choices...)
+	}
+
+	for {
+		answer, err := s.ask(strings.Join(choices, ", ") + "? ")
+		if err != nil {
+			return "", err
+		}
+
+		switch act := action(strings.ToLower(answer)); act {
+		case actionFix:
+			if issue.Replacement != nil {
+				return act, nil
+			}
+
+		case actionNolint, actionExclude, actionSkip, actionQuit:
+			return act, nil
+		}
+
+		fmt.Fprintf(s.out, "Unknown action %q\n", answer)
+	}
+}
+
+// ask prints the prompt and reads the answer: the end of the input quits the session.
+func (s *Session) ask(prompt string) (string, error) {
+	fmt.Fprint(s.out, prompt)
+
+	line, err := s.in.ReadString('\n')
+	if errors.Is(err, io.EOF) && line == "" {
+		fmt.Fprintln(s.out)
+		return "", errQuit
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+
+	return strings.TrimSpace(line), nil
+}
+
+func (s *Session) fix(issue *result.Issue) error {
+	cfg := &config.Config{Issues: config.Issues{NeedFix: true}}
+
+	// A new file cache: the files can be modified by the previous actions.
+	fixer := processors.NewFixer(cfg, s.log, fsutils.NewFileCache())
+
+	// The fixer reads the file: the path without the prefix of `output.path-prefix`.
+	unprefixed := *issue
+	unprefixed.Pos.Filename = issue.RealFilePath()
+
+	notFixed, err := fixer.Process([]result.Issue{unprefixed})
+	if err != nil {
+		return err
+	}
+
+	if len(notFixed) != 0 {
+		return fmt.Errorf("can't fix the issue in %s", issue.FilePath())
+	}
+
+	s.fileModified()
+
+	return nil
+}
+
+func (s *Session) nolint(issue *result.Issue) error {
+	reason, err := s.ask("Reason (optional): ")
+	if err != nil {
+		return err
+	}
+
+	err = insertNolint(issue.RealFilePath(), issue.Line(), issue.FromLinter, reason)
+	if err != nil {
+		return err
+	}
+
+	s.fileModified()
+
+	return nil
+}
+
+func (s *Session) exclude(issue *result.Issue) error {
+	path, err := addExcludeRule(s.configPath, newExcludeRule(issue))
+	if err != nil {
+		return err
+	}
+
+	s.configPath = path
+
+	fmt.Fprintf(s.out, "Exclude rule added to %s\n", path)
+
+	return nil
+}
+
+// fileModified drops the cached lines: the source code is displayed as modified.
+func (s *Session) fileModified() {
+	s.lineCache = fsutils.NewLineCache(fsutils.NewFileCache())
+}
+
+func (s *Session) printSummary(issues []result.Issue) {
+	perFile := map[string]map[string]int{}
+	for i := range issues {
+		path := issues[i].FilePath()
+		if perFile[path] == nil {
+			perFile[path] = map[string]int{}
+		}
+
+		perFile[path][issues[i].FromLinter]++
+	}
+
+	fmt.Fprintf(s.out, "%d issue(s) in %d file(s):\n", len(issues), len(perFile))
+
+	files := maps.Keys(perFile)
+	sort.Strings(files)
+
+	for _, path := range files {
+		linters := maps.Keys(perFile[path])
+		sort.Strings(linters)
+
+		counts := make([]string, 0, len(linters))
+		for _, name := range linters {
+			counts = append(counts, fmt.Sprintf("%s: %d", name, perFile[path][name]))
+		}
+
+		fmt.Fprintf(s.out, "  %s (%s)\n", color.New(color.Bold).Sprint(path), strings.Join(counts, ", "))
+	}
+}
+
+func (s *Session) printIssue(index, total int, issue *result.Issue) {
+	pos := fmt.Sprintf("%s:%d", issue.FilePath(), issue.Line())
+	if issue.Column() != 0 {
+		pos += fmt.Sprintf(":%d", issue.Column())
+	}
+
+	fmt.Fprintf(s.out, "\n[%d/%d] %s: %s (%s)\n", index, total,
+		color.New(color.Bold).Sprint(pos), color.RedString("%s", strings.TrimSpace(issue.Text)), issue.FromLinter)
+
+	lineRange := issue.GetLineRange()
+
+	for line := max(lineRange.From-contextLines, 1); line <= lineRange.To+contextLines; line++ {
+		code, err := s.lineCache.GetLine(issue.RealFilePath(), line)
+		if err != nil {
+			// The end of the file.
+			break
+		}
+
+		marker := " "
+		if line >= lineRange.From && line <= lineRange.To {
+			marker = ">"
+		}
+
+		fmt.Fprintf(s.out, "%s %5d | %s\n", marker, line, code)
+	}
+}
+
+func (s *Session) printStats() {
+	fmt.Fprintf(s.out, "\nFixed: %d, ignored: %d, excluded: %d, skipped: %d\n",
+		s.stats.Fixed, s.stats.Ignored, s.stats.Excluded, s.stats.Skipped)
+}
+
+// sortIssues sorts the issues by file, and from the bottom to the top of each file.
+func sortIssues(issues []result.Issue) []result.Issue {
+	sorted := make([]result.Issue, len(issues))
+	copy(sorted, issues)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := &sorted[i], &sorted[j]
+
+		if a.FilePath() != b.FilePath() {
+			return a.FilePath() < b.FilePath()
+		}
+
+		if a.Line() != b.Line() {
+			return a.Line() > b.Line()
+		}
+
+		return a.Column() > b.Column()
+	})
+
+	return sorted
+}
@@ -0,0 +1,340 @@
+package triage
+
+import (
+	"bytes"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/golangci/golangci-lint/pkg/logutils"
+	"github.com/golangci/golangci-lint/pkg/result"
+)
+
+const sourceCode = `package sample
+
+func a() {
+	x := 1 // the value
+	_ = x
+	y := "foo"
+	_ = y
+}
+`
+
+func TestSession_Run(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "sample.go")
+
+	err := os.WriteFile(path, []byte(sourceCode), 0o600)
+	require.NoError(t, err)
+
+	configPath := filepath.Join(dir, ".golangci.yml")
+
+	err = os.WriteFile(configPath, []byte("# the configuration\nrun:\n  timeout: 5m\n"), 0o600)
+	require.NoError(t, err)
+
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Text:       "x is ignored",
+			Pos:        token.Position{Filename: path, Line: 4, Column: 2},
+		},
+		{
+			FromLinter: "linter-b",
+			Text:       "y is excluded",
+			Pos:        token.Position{Filename: path, Line: 6, Column: 2},
+		},
+		{
+			FromLinter: "linter-c",
+			Text:       "foo should be bar",
+			Pos:        token.Position{Filename: path, Line: 6, Column: 8},
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 7, Length: 3, NewString: "bar"},
+			},
+		},
+		{
+			FromLinter: "linter-d",
+			Text:       "a is skipped",
+			Pos:        token.Position{Filename: path, Line: 3, Column: 6},
+		},
+	}
+
+	// From the bottom of the file: fix, unknown action then exclude, nolint with a reason, skip.
+	input := strings.NewReader("f\nx\ne\nn\nlegacy code\ns\n")
+
+	out := new(bytes.Buffer)
+
+	session := NewSession(logutils.NewStderrLog(logutils.DebugKeyEmpty), configPath, input, out)
+
+	remaining, err := session.Run(issues)
+	require.NoError(t, err)
+
+	require.Len(t, remaining, 1)
+	assert.Equal(t, "linter-d", remaining[0].FromLinter)
+
+	assert.Equal(t, Stats{Fixed: 1, Ignored: 1, Excluded: 1, Skipped: 1}, session.Stats())
+
+	expectedCode := `package sample
+
+func a() {
+	x := 1 //nolint:linter-a // legacy code // the value
+	_ = x
+	y := "bar"
+	_ = y
+}
+`
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedCode, string(content))
+
+	expectedConfig := `# the configuration
+run:
+  timeout: 5m
+issues:
+  exclude-rules:
+    - path: ^` + regexp.QuoteMeta(filepath.ToSlash(path)) + `$
+      linters:
+        - linter-b
+      text: y is excluded
+`
+
+	config, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedConfig, string(config))
+
+	assert.Contains(t, out.String(), "4 issue(s) in 1 file(s):")
+	assert.Contains(t, out.String(), "[1/4] "+path+":6:8: foo should be bar (linter-c)\n"+
+		"      4 | \tx := 1 // the value\n"+
+		"      5 | \t_ = x\n"+
+		">     6 | \ty := \"foo\"\n")
+	assert.Contains(t, out.String(), `Unknown action "x"`)
+	assert.Contains(t, out.String(), "Fixed: 1, ignored: 1, excluded: 1, skipped: 1")
+}
+
+func TestSession_Run_pathPrefix(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "sample.go")
+
+	err := os.WriteFile(path, []byte(sourceCode), 0o600)
+	require.NoError(t, err)
+
+	// The issues are reported with the prefix of output.path-prefix.
+	issues := []result.Issue{
+		{
+			FromLinter: "linter-a",
+			Text:       "x is ignored",
+			Pos:        token.Position{Filename: filepath.Join("prefix", path), Line: 4, Column: 2},
+			RealPath:   path,
+		},
+		{
+			FromLinter: "linter-c",
+			Text:       "foo should be bar",
+			Pos:        token.Position{Filename: filepath.Join("prefix", path), Line: 6, Column: 8},
+			Replacement: &result.Replacement{
+				Inline: &result.InlineFix{StartCol: 7, Length: 3, NewString: "bar"},
+			},
+			RealPath: path,
+		},
+	}
+
+	// From the bottom of the file: fix, nolint without reason.
+	input := strings.NewReader("f\nn\n\n")
+
+	out := new(bytes.Buffer)
+
+	session := NewSession(logutils.NewStderrLog(logutils.DebugKeyEmpty), filepath.Join(dir, ".golangci.yml"), input, out)
+
+	remaining, err := session.Run(issues)
+	require.NoError(t, err)
+
+	assert.Empty(t, remaining)
+
+	expectedCode := `package sample
+
+func a() {
+	x := 1 //nolint:linter-a // the value
+	_ = x
+	y := "bar"
+	_ = y
+}
+`
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, expectedCode, string(content))
+
+	assert.Contains(t, out.String(), ">     6 | \ty := \"foo\"\n")
+}
+
+func TestSession_Run_quit(t *testing.T) {
+	issues := []result.Issue{
+		{FromLinter: "linter-a", Text: "first", Pos: token.Position{Filename: "a.go", Line: 2}},
+		{FromLinter: "linter-a", Text: "second", Pos: token.Position{Filename: "a.go", Line: 1}},
+		{FromLinter: "linter-b", Text: "third", Pos: token.Position{Filename: "b.go", Line: 1}},
+	}
+
+	testCases := []struct {
+		desc  string
+		input string
+	}{
+		{desc: "quit", input: "s\nq\n"},
+		{desc: "end of the input", input: "s\n"},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			session := NewSession(logutils.NewStderrLog(logutils.DebugKeyEmpty), "", strings.NewReader(test.input), new(bytes.Buffer))
+
+			remaining, err := session.Run(issues)
+			require.NoError(t, err)
+
+			require.Len(t, remaining, 3)
+			assert.Equal(t, []string{"first", "second", "third"},
+				[]string{remaining[0].Text, remaining[1].Text, remaining[2].Text})
+		})
+	}
+}
+
+func Test_addNolint(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		line     string
+		reason   string
+		expected string
+	}{
+		{
+			desc:     "no comment",
+			line:     "\tx := 1 ",
+			expected: "\tx := 1 //nolint:linter-a",
+		},
+		{
+			desc:     "reason",
+			line:     "\tx := 1",
+			reason:   "legacy code",
+			expected: "\tx := 1 //nolint:linter-a // legacy code",
+		},
+		{
+			desc:     "comment",
+			line:     "\tx := 1 // the value",
+			expected: "\tx := 1 //nolint:linter-a // the value",
+		},
+		{
+			desc:     "existing directive",
+			line:     "\tx := 1 //nolint:errcheck,gosec // legacy code",
+			reason:   "ignored",
+			expected: "\tx := 1 //nolint:errcheck,gosec,linter-a // legacy code",
+		},
+		{
+			desc:     "comment in a string",
+			line:     "\tx := \"// not a comment\"",
+			expected: "\tx := \"// not a comment\" //nolint:linter-a",
+		},
+		{
+			desc:     "CRLF",
+			line:     "\tx := 1\r",
+			expected: "\tx := 1 //nolint:linter-a\r",
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, string(addNolint([]byte(test.line), "linter-a", test.reason)))
+		})
+	}
+}
+
+func Test_addExcludeRule(t *testing.T) {
+	rule := excludeRule{Path: `^a\.go$`, Linters: []string{"linter-a"}, Text: "some issue"}
+
+	testCases := []struct {
+		desc     string
+		config   string
+		expected string
+	}{
+		{
+			desc: "no configuration file",
+			expected: `issues:
+  exclude-rules:
+    - path: ^a\.go$
+      linters:
+        - linter-a
+      text: some issue
+`,
+		},
+		{
+			desc: "existing rules",
+			config: `issues:
+  exclude-rules: # the exclusions
+    - path: _test\.go
+      linters:
+        - errcheck
+`,
+			expected: `issues:
+  exclude-rules: # the exclusions
+    - path: _test\.go
+      linters:
+        - errcheck
+    - path: ^a\.go$
+      linters:
+        - linter-a
+      text: some issue
+`,
+		},
+		{
+			desc:   "empty rules",
+			config: "issues:\n  exclude-rules: []\n",
+			expected: `issues:
+  exclude-rules:
+    - path: ^a\.go$
+      linters:
+        - linter-a
+      text: some issue
+`,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.desc, func(t *testing.T) {
+			t.Parallel()
+
+			configPath := filepath.Join(t.TempDir(), ".golangci.yaml")
+
+			if test.config != "" {
+				err := os.WriteFile(configPath, []byte(test.config), 0o600)
+				require.NoError(t, err)
+			}
+
+			path, err := addExcludeRule(configPath, rule)
+			require.NoError(t, err)
+
+			assert.Equal(t, configPath, path)
+
+			content, err := os.ReadFile(configPath)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, string(content))
+		})
+	}
+}
+
+func Test_addExcludeRule_error(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), ".golangci.toml")
+
+	_, err := addExcludeRule(configPath, excludeRule{})
+	require.EqualError(t, err, "the exclude rules can only be added to a YAML configuration file: "+configPath)
+}